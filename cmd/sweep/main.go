@@ -1,18 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/vulnverified/sweep/internal/engine"
 	"github.com/vulnverified/sweep/internal/output"
 	"github.com/vulnverified/sweep/internal/recon"
+	"github.com/vulnverified/sweep/internal/takeover"
 	"github.com/vulnverified/sweep/pkg/ports"
 )
 
@@ -23,25 +29,49 @@ func main() {
 	output.Version = version
 
 	var (
-		jsonOutput  bool
-		portsList   string
-		timeout     time.Duration
-		concurrency int
-		noColor     bool
-		silent      bool
-		verbose     bool
-		axfr        bool
+		jsonOutput        bool
+		portsList         string
+		timeout           time.Duration
+		concurrency       int
+		noColor           bool
+		silent            bool
+		verbose           bool
+		axfr              bool
+		resolvers         string
+		doh               bool
+		massResolvers     string
+		massResolveQPS    int
+		eventsPath        string
+		metricsAddr       string
+		checkpointPath    string
+		force             bool
+		scanMode          string
+		packetsPerSec     int
+		outputFormats     string
+		takeoverSigsPath  string
+		jarm              bool
+		sourcesFilter     string
+		excludeSources    string
+		configPath        string
+		certstreamDur     time.Duration
+		resumeRunID       string
+		diffMode          bool
+		fingerprintsDir   string
+		listPath          string
+		targetConcurrency int
+		templatesDir      string
+		severityFilter    string
 	)
 
 	rootCmd := &cobra.Command{
-		Use:   "sweep <domain>",
+		Use:   "sweep <domain|ip|cidr|AS#> [flags]",
 		Short: "Sweep your attack surface",
 		Long:  "External attack surface recon â€” subdomain enumeration, DNS resolution, port scanning, HTTP probing, and tech fingerprinting.",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			domain := strings.ToLower(strings.TrimSpace(args[0]))
-			if domain == "" {
-				return fmt.Errorf("domain is required")
+			rawTargets, err := gatherRawTargets(args, listPath)
+			if err != nil {
+				return err
 			}
 
 			// Respect NO_COLOR env var.
@@ -59,14 +89,46 @@ func main() {
 				scanPorts = parsed
 			}
 
+			if scanMode != engine.ScanModeConnect && scanMode != engine.ScanModeSYN {
+				return fmt.Errorf("invalid --scan-mode %q (want %q or %q)", scanMode, engine.ScanModeConnect, engine.ScanModeSYN)
+			}
+
+			if resumeRunID != "" && checkpointPath != "" {
+				return fmt.Errorf("--resume cannot be combined with an explicit --checkpoint path")
+			}
+			if diffMode && checkpointPath != "" {
+				return fmt.Errorf("--diff requires the default run store; don't combine it with --checkpoint")
+			}
+
 			userAgent := fmt.Sprintf("sweep/%s (+https://github.com/vulnverified/sweep)", version)
 
-			cfg := engine.Config{
-				Target:      domain,
-				Ports:       scanPorts,
-				Timeout:     timeout,
-				Concurrency: concurrency,
-				UserAgent:   userAgent,
+			if takeoverSigsPath != "" {
+				if err := takeover.LoadSignaturesFile(takeoverSigsPath); err != nil {
+					return fmt.Errorf("--takeover-signatures: %w", err)
+				}
+			}
+
+			if fingerprintsDir != "" {
+				if err := recon.LoadFingerprintsDir(fingerprintsDir); err != nil {
+					return fmt.Errorf("--fingerprints: %w", err)
+				}
+			}
+
+			if templatesDir != "" {
+				if err := recon.LoadTemplatesDir(templatesDir); err != nil {
+					return fmt.Errorf("--templates: %w", err)
+				}
+			}
+
+			switch strings.ToLower(severityFilter) {
+			case "", "info", "low", "medium", "high", "critical":
+			default:
+				return fmt.Errorf("invalid --severity %q (want one of info, low, medium, high, critical)", severityFilter)
+			}
+
+			resolverURIs := parseResolvers(resolvers)
+			if len(resolverURIs) == 0 && doh {
+				resolverURIs = defaultDoHResolvers
 			}
 
 			// Set up context with signal handling for clean Ctrl+C.
@@ -81,52 +143,211 @@ func main() {
 				cancel()
 			}()
 
-			// Wire up stages.
-			prober := &recon.Prober{UserAgent: userAgent}
-			stages := engine.Stages{
-				Enumerator: &recon.Enumerator{
-					UserAgent: userAgent,
-					AXFR:      axfr,
-					Progress:  nil, // set below
-				},
-				Resolver:      &recon.Resolver{},
-				Scanner:       &recon.Scanner{},
-				Prober:        prober,
-				Fingerprinter: &recon.Fingerprinter{Prober: prober},
-			}
-
-			// Progress output.
-			showProgress := !jsonOutput && !silent
-			progress := output.NewProgress(os.Stderr, verbose, !showProgress)
+			targets, warnings, err := recon.ExpandTargets(ctx, rawTargets)
+			if err != nil {
+				return fmt.Errorf("expanding targets: %w", err)
+			}
+			for _, w := range warnings {
+				fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+			}
+			if len(targets) == 0 {
+				return fmt.Errorf("no targets to scan")
+			}
 
-			// Set progress on enumerator for per-source detail.
-			stages.Enumerator.(*recon.Enumerator).Progress = progress
+			dnsResolver, err := recon.NewUpstreamResolver(resolverURIs)
+			if err != nil {
+				return fmt.Errorf("resolver: %w", err)
+			}
 
-			// Print header.
-			if showProgress {
-				output.WriteHeader(os.Stderr, noColor)
+			var observers output.MultiObserver
+			if eventsPath != "" {
+				eventsFile, err := os.Create(eventsPath)
+				if err != nil {
+					return fmt.Errorf("open --events-jsonl path: %w", err)
+				}
+				defer eventsFile.Close()
+				observers = append(observers, output.NewJSONLObserver(eventsFile))
+			}
+			if metricsAddr != "" {
+				metrics, err := output.NewMetricsObserver(metricsAddr)
+				if err != nil {
+					return fmt.Errorf("start --metrics-addr listener: %w", err)
+				}
+				defer metrics.Close()
+				observers = append(observers, metrics)
 			}
 
-			// Run the pipeline.
-			result, err := engine.Run(ctx, cfg, stages, progress)
+			// Wire up provider keys, shared across every target.
+			providersPath := configPath
+			if providersPath == "" {
+				providersPath = recon.DefaultProvidersConfigPath()
+			}
+			providerKeys, err := recon.LoadProviderKeys(providersPath)
 			if err != nil {
-				return err
+				return fmt.Errorf("load provider keys: %w", err)
 			}
 
+			// Progress output, shared across every target; output.Progress is
+			// internally synchronized so concurrent targets can share it.
+			showProgress := !jsonOutput && !silent
+			progress := output.NewProgress(os.Stderr, verbose, !showProgress)
 			if showProgress {
-				progress.Complete()
+				output.WriteHeader(os.Stderr, noColor)
 			}
 
-			// Output results.
-			if jsonOutput {
-				return output.WriteJSON(os.Stdout, result)
+			// stdoutMu serializes writes to os.Stdout across concurrently
+			// running targets, so runMany's output doesn't interleave.
+			var stdoutMu sync.Mutex
+
+			// runOne scans a single target end to end: checkpoint/run-store
+			// setup, the pipeline itself, and writing its results. It uses
+			// local runPath/runID/runStore rather than mutating the outer
+			// flag variables, so concurrent targets don't clobber each
+			// other's state. All of a target's stdout output is rendered to
+			// an in-memory buffer first and flushed in one write under
+			// stdoutMu, so concurrent targets can't garble each other's
+			// table/summary/JSON/formatter output.
+			runOne := func(target recon.Target) error {
+				var runStore *engine.RunStore
+				runPath := checkpointPath
+				runID := resumeRunID
+				if runPath == "" {
+					runStore = engine.NewRunStore("")
+					if runID == "" {
+						runID = runStore.NewRunID()
+					}
+					runPath = runStore.RunPath(target.Value, runID)
+					if err := os.MkdirAll(filepath.Dir(runPath), 0o755); err != nil {
+						return fmt.Errorf("create run store directory: %w", err)
+					}
+				}
+
+				if runPath != "" && force {
+					if err := os.Remove(runPath); err != nil && !os.IsNotExist(err) {
+						return fmt.Errorf("--force: removing checkpoint: %w", err)
+					}
+				}
+
+				var enumerator engine.SubdomainEnumerator
+				if target.IsIP {
+					enumerator = recon.IPEnumerator{}
+				} else {
+					enumerator = &recon.Enumerator{
+						UserAgent:            userAgent,
+						AXFR:                 axfr,
+						Progress:             progress,
+						Resolver:             dnsResolver,
+						MassResolveUpstreams: parseResolvers(massResolvers),
+						MassResolveQPS:       massResolveQPS,
+						ProviderKeys:         providerKeys,
+						SourceFilter:         parseResolvers(sourcesFilter),
+						ExcludeSources:       parseResolvers(excludeSources),
+						CertStreamDuration:   certstreamDur,
+					}
+				}
+
+				cfg := engine.Config{
+					Target:           target.Value,
+					Ports:            scanPorts,
+					Timeout:          timeout,
+					Concurrency:      concurrency,
+					UserAgent:        userAgent,
+					Resolvers:        resolverURIs,
+					Checkpoint:       runPath,
+					ScanMode:         scanMode,
+					PacketsPerSecond: packetsPerSec,
+				}
+				if len(observers) > 0 {
+					cfg.Observer = observers
+				}
+
+				prober := &recon.Prober{UserAgent: userAgent, JARM: jarm}
+				stages := engine.Stages{
+					Enumerator:    enumerator,
+					Resolver:      dnsResolver,
+					Scanner:       &recon.Scanner{Mode: cfg.ScanMode, PacketsPerSecond: cfg.PacketsPerSecond},
+					Prober:        prober,
+					Fingerprinter: &recon.Fingerprinter{Prober: prober},
+					Verifier:      &takeover.Verifier{UserAgent: userAgent},
+					VulnScanner:   &recon.VulnScanner{UserAgent: userAgent, Prober: prober, MinSeverity: severityFilter},
+				}
+
+				var diffBaseline *engine.ScanResult
+				if diffMode && runStore != nil {
+					prevID, err := runStore.LatestRunID(target.Value)
+					if err != nil {
+						progress.Warn(fmt.Sprintf("--diff: %s", err))
+					} else if prevID != "" && prevID != runID {
+						if diffBaseline, err = runStore.Load(target.Value, prevID); err != nil {
+							progress.Warn(fmt.Sprintf("--diff: loading previous run: %s", err))
+						}
+					}
+				}
+
+				result, err := engine.Run(ctx, cfg, stages, progress)
+				if err != nil {
+					return err
+				}
+				if target.Origin != target.Value {
+					result.OriginTarget = target.Origin
+				}
+
+				if runStore != nil {
+					if err := runStore.SetLatestRunID(target.Value, runID); err != nil {
+						progress.Warn(fmt.Sprintf("run store: %s", err))
+					}
+				}
+
+				var buf bytes.Buffer
+				if jsonOutput {
+					if err := output.WriteJSON(&buf, result); err != nil {
+						return err
+					}
+				} else {
+					showCTA := !silent
+					output.WriteTable(&buf, result, noColor)
+					output.WriteSummary(&buf, result, showCTA, noColor)
+				}
+
+				if diffMode {
+					diff := engine.Diff(diffBaseline, result)
+					if jsonOutput {
+						if err := output.WriteDiffJSON(&buf, diff); err != nil {
+							return err
+						}
+					} else {
+						output.WriteDiff(&buf, diff, noColor)
+					}
+				}
+
+				if outputFormats != "" {
+					if err := writeFormats(outputFormats, result, &buf); err != nil {
+						return err
+					}
+				}
+
+				stdoutMu.Lock()
+				_, err = os.Stdout.Write(buf.Bytes())
+				stdoutMu.Unlock()
+				if err != nil {
+					return err
+				}
+
+				return nil
 			}
 
-			showCTA := !silent
-			output.WriteTable(os.Stdout, result, noColor)
-			output.WriteSummary(os.Stdout, result, showCTA, noColor)
+			var runErr error
+			if len(targets) == 1 {
+				runErr = runOne(targets[0])
+			} else {
+				runErr = runMany(targets, targetConcurrency, runOne)
+			}
 
-			return nil
+			if showProgress {
+				progress.Complete()
+			}
+
+			return runErr
 		},
 	}
 
@@ -138,6 +359,30 @@ func main() {
 	rootCmd.Flags().BoolVar(&silent, "silent", false, "Results only, no progress or CTA")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Verbose per-source progress")
 	rootCmd.Flags().BoolVar(&axfr, "axfr", false, "Test for DNS zone transfers")
+	rootCmd.Flags().StringVar(&resolvers, "resolvers", "", "Comma-separated upstream DNS resolver URIs to round-robin (https://host/dns-query for DoH, tls://host:853 for DoT, udp://host:53 for plain)")
+	rootCmd.Flags().BoolVar(&doh, "doh", false, "Resolve via public DoH upstreams (Cloudflare, Google) instead of the system resolver; ignored if --resolvers is set")
+	rootCmd.Flags().StringVar(&massResolvers, "mass-resolvers", "", "Comma-separated host:port plain-DNS upstreams for a pipelined high-qps brute-force resolver (replaces --resolvers for brute-force only)")
+	rootCmd.Flags().IntVar(&massResolveQPS, "mass-resolve-qps", 0, "Per-upstream query rate cap for --mass-resolvers (0 = resolver default)")
+	rootCmd.Flags().StringVar(&eventsPath, "events-jsonl", "", "Write structured pipeline events as JSONL to this path")
+	rootCmd.Flags().StringVar(&metricsAddr, "metrics-addr", "", "Serve Prometheus metrics at <addr>/metrics while the scan runs")
+	rootCmd.Flags().StringVar(&checkpointPath, "checkpoint", "", "Persist and resume scan progress from this file")
+	rootCmd.Flags().BoolVar(&force, "force", false, "Ignore any existing --checkpoint and start over")
+	rootCmd.Flags().StringVar(&scanMode, "scan-mode", engine.ScanModeConnect, "Port scan technique: connect or syn (falls back to connect if raw sockets are unavailable)")
+	rootCmd.Flags().IntVar(&packetsPerSec, "packets-per-second", 0, "Cap the SYN scanner's send rate (0 = unlimited; ignored in connect mode)")
+	rootCmd.Flags().StringVar(&outputFormats, "output", "", "Comma-separated additional output formats to write, each as fmt or fmt:path (jsonl, csv, sarif, markdown; path \"-\" or empty means stdout)")
+	rootCmd.Flags().StringVar(&takeoverSigsPath, "takeover-signatures", "", "Path to a YAML file of takeover signatures, replacing the bundled starter set")
+	rootCmd.Flags().BoolVar(&jarm, "jarm", false, "Compute a JARM TLS fingerprint for each HTTPS service (ten extra handshakes per target)")
+	rootCmd.Flags().StringVar(&sourcesFilter, "sources", "", "Comma-separated list of passive sources to use (default: all enabled sources)")
+	rootCmd.Flags().StringVar(&excludeSources, "exclude-sources", "", "Comma-separated list of passive sources to skip")
+	rootCmd.Flags().StringVar(&configPath, "config", "", "Path to the provider API-key config file (default: ~/.config/sweep/providers.yaml)")
+	rootCmd.Flags().DurationVar(&certstreamDur, "certstream-duration", 0, "Listen on the CertStream CT-log websocket firehose for this long per run for near-real-time cert discovery (0 disables)")
+	rootCmd.Flags().StringVar(&resumeRunID, "resume", "", "Resume a previous run by ID from the run store (~/.local/state/sweep/<domain>/) instead of starting a fresh one")
+	rootCmd.Flags().BoolVar(&diffMode, "diff", false, "Print what changed since the previous completed run for this target (new subdomains, newly open ports, changed tech stack, disappeared services)")
+	rootCmd.Flags().StringVar(&fingerprintsDir, "fingerprints", "", "Directory of Wappalyzer-format technology JSON files (apps.json or split technologies/*.json) to replace the built-in fingerprint rules")
+	rootCmd.Flags().StringVarP(&listPath, "list", "l", "", "File of newline-separated targets (domain, IP, CIDR, or AS#) to scan, one per line, '#' for comments; replaces the positional argument")
+	rootCmd.Flags().IntVar(&targetConcurrency, "target-concurrency", 5, "Max targets scanned in parallel when --list or a CIDR/ASN expands to more than one")
+	rootCmd.Flags().StringVar(&templatesDir, "templates", "", "Directory of YAML vulnerability check templates to replace the built-in starter set")
+	rootCmd.Flags().StringVar(&severityFilter, "severity", "", "Minimum finding severity to report: info, low, medium, high, or critical (default: report every match)")
 
 	rootCmd.Version = version
 	rootCmd.SetVersionTemplate("sweep {{.Version}}\n")
@@ -147,6 +392,100 @@ func main() {
 	}
 }
 
+// defaultDoHResolvers backs --doh: Cloudflare and Google's DoH endpoints,
+// given as IP literals so no bootstrap resolution is needed.
+var defaultDoHResolvers = []string{
+	"https://1.1.1.1/dns-query",
+	"https://8.8.8.8/dns-query",
+}
+
+// gatherRawTargets returns the raw (unexpanded) target strings for a run:
+// either the single positional argument, or every non-blank, non-comment
+// line of the --list file. Exactly one of the two must be given.
+func gatherRawTargets(args []string, listPath string) ([]string, error) {
+	if listPath != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("--list cannot be combined with a positional target")
+		}
+		f, err := os.Open(listPath)
+		if err != nil {
+			return nil, fmt.Errorf("--list: %w", err)
+		}
+		defer f.Close()
+
+		var targets []string
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			targets = append(targets, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("--list: %w", err)
+		}
+		if len(targets) == 0 {
+			return nil, fmt.Errorf("--list: no targets found in %s", listPath)
+		}
+		return targets, nil
+	}
+
+	if len(args) != 1 {
+		return nil, fmt.Errorf("a target (domain, IP, CIDR, or AS#) or --list is required")
+	}
+	return args, nil
+}
+
+// runMany scans every target concurrently, bounded by workers (falling back
+// to a sane default if unset), printing each target's failure rather than
+// aborting the whole run. It returns a combined error summarizing how many
+// targets failed, or nil if every target succeeded.
+func runMany(targets []recon.Target, workers int, runOne func(recon.Target) error) error {
+	if workers <= 0 {
+		workers = 5
+	}
+
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failures int
+
+	for _, target := range targets {
+		target := target
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := runOne(target); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", target.Value, err)
+				mu.Lock()
+				failures++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d targets failed", failures, len(targets))
+	}
+	return nil
+}
+
+// parseResolvers splits a comma-separated list of upstream resolver URIs,
+// trimming whitespace and dropping empty entries.
+func parseResolvers(s string) []string {
+	var out []string
+	for _, r := range strings.Split(s, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
 // parsePorts parses a comma-separated list of port numbers.
 func parsePorts(s string) ([]int, error) {
 	parts := strings.Split(s, ",")
@@ -176,3 +515,45 @@ func parsePorts(s string) ([]int, error) {
 	}
 	return result, nil
 }
+
+// writeFormats parses a comma-separated --output spec of "fmt" or
+// "fmt:path" items and writes result through each named formatter, to
+// stdout if path is omitted, empty, or "-". stdout is the writer to use
+// in place of os.Stdout, so callers running multiple targets concurrently
+// can buffer a target's output and flush it atomically.
+func writeFormats(spec string, result *engine.ScanResult, stdout io.Writer) error {
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, path := item, "-"
+		if idx := strings.Index(item, ":"); idx >= 0 {
+			name, path = item[:idx], item[idx+1:]
+		}
+
+		formatter, err := output.NewFormatter(name)
+		if err != nil {
+			return fmt.Errorf("--output: %w", err)
+		}
+
+		w := stdout
+		if path != "" && path != "-" {
+			f, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("--output: opening %q: %w", path, err)
+			}
+			defer f.Close()
+			if err := formatter.Format(f, result); err != nil {
+				return fmt.Errorf("--output %s: %w", name, err)
+			}
+			continue
+		}
+
+		if err := formatter.Format(w, result); err != nil {
+			return fmt.Errorf("--output %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// saveCheckpoint atomically persists result to path: it writes to a
+// sibling ".tmp" file and renames it over path, so a crash mid-write
+// never leaves a corrupt or partially-written checkpoint behind.
+func saveCheckpoint(path string, result *ScanResult) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename checkpoint: %w", err)
+	}
+	return nil
+}
+
+// loadCheckpoint reads a previously saved checkpoint, if one exists. A
+// missing file is not an error — it just means there's nothing to
+// resume from.
+func loadCheckpoint(path string) (*ScanResult, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	var result ScanResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("parse checkpoint: %w", err)
+	}
+	return &result, nil
+}
+
+// ResumableScanner is an optional interface a PortScanner can satisfy to
+// skip (host, port) pairs a checkpoint already recorded as open, instead
+// of re-dialing them. Run calls ResumeScan instead of Scan whenever a
+// checkpoint with prior open ports is available, even if the port stage
+// itself wasn't marked complete — e.g. the user reran with a different
+// --ports list after an earlier interruption.
+type ResumableScanner interface {
+	ResumeScan(ctx context.Context, dnsRecords []DNSResult, ports []int, concurrency int, timeout time.Duration, prior []PortResult) ([]PortResult, error)
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"sort"
+	"strconv"
+)
+
+// RunDiff summarizes what changed between two ScanResults for the same
+// target, for --diff mode: new subdomains, newly opened ports, disappeared
+// HTTP services, and services whose detected tech stack changed.
+type RunDiff struct {
+	NewSubdomains       []string     `json:"new_subdomains,omitempty"`
+	NewOpenPorts        []PortResult `json:"new_open_ports,omitempty"`
+	DisappearedServices []string     `json:"disappeared_services,omitempty"` // URLs present before, gone now
+	ChangedTech         []TechChange `json:"changed_tech,omitempty"`
+}
+
+// TechChange describes a service whose detected technology names changed
+// between runs.
+type TechChange struct {
+	URL    string   `json:"url"`
+	Before []string `json:"before"`
+	After  []string `json:"after"`
+}
+
+// Empty reports whether nothing changed between the two runs.
+func (d RunDiff) Empty() bool {
+	return len(d.NewSubdomains) == 0 && len(d.NewOpenPorts) == 0 &&
+		len(d.DisappearedServices) == 0 && len(d.ChangedTech) == 0
+}
+
+// Diff compares prior against current and returns what changed. prior may
+// be nil, in which case everything in current is reported as new.
+func Diff(prior, current *ScanResult) RunDiff {
+	var d RunDiff
+
+	priorHosts := make(map[string]bool)
+	priorPorts := make(map[string]bool)
+	priorServices := make(map[string][]string) // URL -> tech names
+	if prior != nil {
+		for _, sub := range prior.Subdomains {
+			priorHosts[sub.Host] = true
+		}
+		for _, p := range prior.OpenPorts {
+			priorPorts[portKey(p)] = true
+		}
+		for _, svc := range prior.HTTPServices {
+			priorServices[svc.URL] = techNames(svc.Technologies)
+		}
+	}
+
+	for _, sub := range current.Subdomains {
+		if !priorHosts[sub.Host] {
+			d.NewSubdomains = append(d.NewSubdomains, sub.Host)
+		}
+	}
+	sort.Strings(d.NewSubdomains)
+
+	for _, p := range current.OpenPorts {
+		if !priorPorts[portKey(p)] {
+			d.NewOpenPorts = append(d.NewOpenPorts, p)
+		}
+	}
+
+	currentServices := make(map[string]bool)
+	for _, svc := range current.HTTPServices {
+		currentServices[svc.URL] = true
+		before, existed := priorServices[svc.URL]
+		if !existed {
+			continue
+		}
+		after := techNames(svc.Technologies)
+		if !sameStrings(before, after) {
+			d.ChangedTech = append(d.ChangedTech, TechChange{URL: svc.URL, Before: before, After: after})
+		}
+	}
+
+	if prior != nil {
+		for _, svc := range prior.HTTPServices {
+			if !currentServices[svc.URL] {
+				d.DisappearedServices = append(d.DisappearedServices, svc.URL)
+			}
+		}
+	}
+	sort.Strings(d.DisappearedServices)
+
+	return d
+}
+
+func portKey(p PortResult) string {
+	return p.Host + ":" + p.IP + ":" + strconv.Itoa(p.Port)
+}
+
+func techNames(techs []Technology) []string {
+	names := make([]string, 0, len(techs))
+	for _, t := range techs {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package engine
+
+import "testing"
+
+func TestDiffDetectsNewSubdomainsAndPorts(t *testing.T) {
+	prior := &ScanResult{
+		Subdomains: []Subdomain{{Host: "www.example.com"}},
+		OpenPorts:  []PortResult{{Host: "www.example.com", IP: "1.1.1.1", Port: 443}},
+	}
+	current := &ScanResult{
+		Subdomains: []Subdomain{{Host: "www.example.com"}, {Host: "api.example.com"}},
+		OpenPorts: []PortResult{
+			{Host: "www.example.com", IP: "1.1.1.1", Port: 443},
+			{Host: "api.example.com", IP: "1.1.1.2", Port: 8080},
+		},
+	}
+
+	d := Diff(prior, current)
+	if len(d.NewSubdomains) != 1 || d.NewSubdomains[0] != "api.example.com" {
+		t.Errorf("got %v, want [api.example.com]", d.NewSubdomains)
+	}
+	if len(d.NewOpenPorts) != 1 || d.NewOpenPorts[0].Port != 8080 {
+		t.Errorf("got %v, want one new port 8080", d.NewOpenPorts)
+	}
+}
+
+func TestDiffDetectsDisappearedServicesAndChangedTech(t *testing.T) {
+	prior := &ScanResult{
+		HTTPServices: []HTTPService{
+			{URL: "https://www.example.com", Technologies: []Technology{{Name: "nginx"}}},
+			{URL: "https://old.example.com"},
+		},
+	}
+	current := &ScanResult{
+		HTTPServices: []HTTPService{
+			{URL: "https://www.example.com", Technologies: []Technology{{Name: "nginx"}, {Name: "wordpress"}}},
+		},
+	}
+
+	d := Diff(prior, current)
+	if len(d.DisappearedServices) != 1 || d.DisappearedServices[0] != "https://old.example.com" {
+		t.Errorf("got %v, want [https://old.example.com]", d.DisappearedServices)
+	}
+	if len(d.ChangedTech) != 1 || d.ChangedTech[0].URL != "https://www.example.com" {
+		t.Fatalf("got %v, want one changed-tech entry for www.example.com", d.ChangedTech)
+	}
+	if len(d.ChangedTech[0].After) != 2 {
+		t.Errorf("got %v, want 2 technologies after", d.ChangedTech[0].After)
+	}
+}
+
+func TestDiffNilPriorReportsEverythingAsNew(t *testing.T) {
+	current := &ScanResult{Subdomains: []Subdomain{{Host: "www.example.com"}}}
+	d := Diff(nil, current)
+	if len(d.NewSubdomains) != 1 {
+		t.Errorf("got %v, want 1 new subdomain", d.NewSubdomains)
+	}
+	if d.Empty() {
+		t.Error("expected non-empty diff")
+	}
+}
+
+func TestRunDiffEmpty(t *testing.T) {
+	if !(RunDiff{}).Empty() {
+		t.Error("expected zero-value RunDiff to be empty")
+	}
+}
@@ -13,8 +13,47 @@ type Config struct {
 	Timeout     time.Duration
 	Concurrency int
 	UserAgent   string
+
+	// Resolvers is a list of upstream DNS resolver URIs to round-robin
+	// across instead of the platform stub resolver, e.g.
+	// "https://1.1.1.1/dns-query" (DoH), "tls://8.8.8.8:853" (DoT), or
+	// "udp://9.9.9.9:53" (plain). Empty uses the platform resolver.
+	Resolvers []string
+
+	// Observer, if set, receives structured events for every pipeline step.
+	Observer Observer
+
+	// Checkpoint, if set, is a file path Run atomically updates after each
+	// stage with the partial ScanResult. If the file already exists and its
+	// Target matches cfg.Target, Run reuses the checkpoint's subdomains and
+	// DNS records instead of running those two stages again; the later
+	// stages run again (see Run).
+	Checkpoint string
+
+	// ScanMode selects the port-scan technique (ScanModeConnect or
+	// ScanModeSYN). It's consumed when constructing the PortScanner, not
+	// by Run, which only ever calls the PortScanner interface.
+	ScanMode string
+
+	// PacketsPerSecond caps the SYN scanner's send rate. Zero means
+	// unlimited. Ignored in connect mode.
+	PacketsPerSecond int
 }
 
+// noopObserver discards every event. Used when Config.Observer is nil so
+// Run doesn't need a nil check before every call.
+type noopObserver struct{}
+
+func (noopObserver) StageStarted(stage string)                                              {}
+func (noopObserver) StageCompleted(stage string, duration time.Duration, count, errors int) {}
+func (noopObserver) SubdomainDiscovered(host string, sources []string)                      {}
+func (noopObserver) HostResolved(host string, ips []string)                                 {}
+func (noopObserver) PortOpen(host, ip string, port int)                                     {}
+func (noopObserver) HTTPServiceFound(svc HTTPService)                                       {}
+func (noopObserver) TechIdentified(url string, tech Technology)                             {}
+func (noopObserver) ZoneTransferAttempt(nameserver string, success bool, records int)       {}
+func (noopObserver) VulnFindingDiscovered(finding Finding)                                  {}
+
 // Stages holds the injectable stage implementations.
 type Stages struct {
 	Enumerator    SubdomainEnumerator
@@ -22,6 +61,17 @@ type Stages struct {
 	Scanner       PortScanner
 	Prober        HTTPProber
 	Fingerprinter TechFingerprinter
+
+	// Verifier, if set, enriches dangling CNAMEs found during DNS
+	// resolution with the takeover service, severity, and evidence. It's
+	// optional: a nil Verifier leaves DanglingCNAMEs as raw NXDOMAIN/
+	// SERVFAIL detections.
+	Verifier TakeoverVerifier
+
+	// VulnScanner, if set, runs templated vulnerability checks against
+	// probed HTTP services after fingerprinting. Optional: a nil
+	// VulnScanner leaves ScanResult.Findings empty.
+	VulnScanner VulnScanner
 }
 
 // ProgressReporter is called by the engine to report stage progress.
@@ -31,33 +81,78 @@ type ProgressReporter interface {
 	Warn(msg string)
 }
 
-const totalStages = 5
+const totalStages = 6
 
-// Run executes the full sweep pipeline.
+// Run executes the full sweep pipeline. If cfg.Checkpoint is set, Run
+// writes the partially-populated result to that path after every stage
+// and, on the next invocation against the same target, skips the
+// subdomain and DNS stages when the checkpoint holds their results. The
+// port stage always runs, passing prior open ports to ResumeScan when the
+// scanner is a ResumableScanner; the HTTP, fingerprint, and
+// vulnerability stages always run again, even if the checkpoint already
+// recorded their results.
 func Run(ctx context.Context, cfg Config, stages Stages, progress ProgressReporter) (*ScanResult, error) {
+	obs := cfg.Observer
+	if obs == nil {
+		obs = noopObserver{}
+	}
+
+	var prior *ScanResult
+	if cfg.Checkpoint != "" {
+		loaded, err := loadCheckpoint(cfg.Checkpoint)
+		if err != nil {
+			progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+		} else if loaded != nil && loaded.Target == cfg.Target {
+			prior = loaded
+		}
+	}
+
 	result := &ScanResult{
 		Target:    cfg.Target,
 		StartedAt: time.Now(),
 	}
 
 	// Stage 1: Subdomain enumeration.
-	progress.Stage(1, totalStages, "Enumerating subdomains...")
-	subdomains, err := stages.Enumerator.Enumerate(ctx, cfg.Target, cfg.Concurrency)
-	if err != nil {
-		return nil, fmt.Errorf("subdomain enumeration failed: %w", err)
-	}
-	if len(subdomains) == 0 {
-		return nil, fmt.Errorf("no subdomains discovered for %s", cfg.Target)
-	}
-	result.Subdomains = subdomains
-	progress.Detail(fmt.Sprintf("Found %d unique subdomains", len(subdomains)))
+	var subdomains []Subdomain
+	if prior != nil && len(prior.Subdomains) > 0 {
+		progress.Detail(fmt.Sprintf("Resuming from checkpoint: reusing %d subdomains", len(prior.Subdomains)))
+		subdomains = prior.Subdomains
+		result.ZoneTransfers = prior.ZoneTransfers
+		result.Warnings = prior.Warnings
+	} else {
+		progress.Stage(1, totalStages, "Enumerating subdomains...")
+		obs.StageStarted("subdomains")
+		stageStart := time.Now()
+		enumerated, err := stages.Enumerator.Enumerate(ctx, cfg.Target, cfg.Concurrency)
+		if err != nil {
+			obs.StageCompleted("subdomains", time.Since(stageStart), 0, 1)
+			return nil, fmt.Errorf("subdomain enumeration failed: %w", err)
+		}
+		if len(enumerated) == 0 {
+			obs.StageCompleted("subdomains", time.Since(stageStart), 0, 1)
+			return nil, fmt.Errorf("no subdomains discovered for %s", cfg.Target)
+		}
+		subdomains = enumerated
+		for _, s := range subdomains {
+			obs.SubdomainDiscovered(s.Host, s.Sources)
+		}
+		obs.StageCompleted("subdomains", time.Since(stageStart), len(subdomains), 0)
+		progress.Detail(fmt.Sprintf("Found %d unique subdomains", len(subdomains)))
 
-	// Check optional interfaces for zone transfers and warnings.
-	if ztp, ok := stages.Enumerator.(ZoneTransferProvider); ok {
-		result.ZoneTransfers = ztp.GetZoneTransfers()
+		// Check optional interfaces for zone transfers and warnings.
+		if ztp, ok := stages.Enumerator.(ZoneTransferProvider); ok {
+			result.ZoneTransfers = ztp.GetZoneTransfers()
+			for _, zt := range result.ZoneTransfers {
+				obs.ZoneTransferAttempt(zt.Nameserver, zt.Success, zt.Records)
+			}
+		}
+		if wp, ok := stages.Enumerator.(WarningProvider); ok {
+			result.Warnings = wp.GetWarnings()
+		}
 	}
-	if wp, ok := stages.Enumerator.(WarningProvider); ok {
-		result.Warnings = wp.GetWarnings()
+	result.Subdomains = subdomains
+	if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+		progress.Warn(fmt.Sprintf("checkpoint: %s", err))
 	}
 
 	// Collect all hostnames for DNS resolution.
@@ -67,13 +162,60 @@ func Run(ctx context.Context, cfg Config, stages Stages, progress ProgressReport
 	}
 
 	// Stage 2: DNS resolution.
-	progress.Stage(2, totalStages, "Resolving DNS records...")
-	dnsRecords, danglingCNAMEs, err := stages.Resolver.Resolve(ctx, hosts, cfg.Concurrency)
-	if err != nil {
-		progress.Warn(fmt.Sprintf("DNS resolution error: %s", err))
+	var dnsRecords []DNSResult
+	var danglingCNAMEs []DanglingCNAME
+	if prior != nil && prior.DNSRecords != nil {
+		progress.Detail(fmt.Sprintf("Resuming from checkpoint: reusing %d DNS records", len(prior.DNSRecords)))
+		dnsRecords = prior.DNSRecords
+		danglingCNAMEs = prior.DanglingCNAMEs
+		result.DNSFindings = prior.DNSFindings
+	} else {
+		progress.Stage(2, totalStages, "Resolving DNS records...")
+		obs.StageStarted("dns")
+		stageStart := time.Now()
+		resolved, detectedDangling, err := stages.Resolver.Resolve(ctx, cfg.Target, hosts, cfg.Concurrency)
+		stageErrs := 0
+		if err != nil {
+			progress.Warn(fmt.Sprintf("DNS resolution error: %s", err))
+			stageErrs = 1
+		}
+		dnsRecords = resolved
+		danglingCNAMEs = detectedDangling
+
+		liveCount := 0
+		for _, r := range dnsRecords {
+			if len(r.IPs) > 0 {
+				liveCount++
+				obs.HostResolved(r.Host, r.IPs)
+			}
+		}
+		obs.StageCompleted("dns", time.Since(stageStart), liveCount, stageErrs)
+		progress.Detail(fmt.Sprintf("%d hosts resolved, %d dangling CNAMEs detected", liveCount, len(danglingCNAMEs)))
+
+		if dfp, ok := stages.Resolver.(DNSFindingsProvider); ok {
+			result.DNSFindings = dfp.GetDNSFindings()
+			if len(result.DNSFindings) > 0 {
+				progress.Detail(fmt.Sprintf("%d DNS findings", len(result.DNSFindings)))
+			}
+			for _, f := range result.DNSFindings {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s", f.Category, f.Detail))
+			}
+		}
+
+		if stages.Verifier != nil && len(danglingCNAMEs) > 0 {
+			verified, err := stages.Verifier.Verify(ctx, danglingCNAMEs, cfg.Concurrency, cfg.Timeout)
+			if err != nil {
+				progress.Warn(fmt.Sprintf("takeover verification error: %s", err))
+			} else {
+				danglingCNAMEs = verified
+			}
+		}
 	}
 	result.DNSRecords = dnsRecords
 	result.DanglingCNAMEs = danglingCNAMEs
+	if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+		progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+	}
 
 	liveHostCount := 0
 	for _, r := range dnsRecords {
@@ -81,23 +223,45 @@ func Run(ctx context.Context, cfg Config, stages Stages, progress ProgressReport
 			liveHostCount++
 		}
 	}
-	progress.Detail(fmt.Sprintf("%d hosts resolved, %d dangling CNAMEs detected", liveHostCount, len(danglingCNAMEs)))
 
 	if liveHostCount == 0 {
 		progress.Warn("No live hosts found, skipping port scan and HTTP probe")
 		result.CompletedAt = time.Now()
 		result.DurationSecs = result.CompletedAt.Sub(result.StartedAt).Seconds()
 		result.Summary = buildSummary(result)
+		if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+			progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+		}
 		return result, nil
 	}
 
-	// Stage 3: Port scanning.
+	// Stage 3: Port scanning. If the scanner supports resuming and a
+	// checkpoint recorded ports already found open, reuse those and only
+	// scan the pairs not yet accounted for (e.g. after --ports changed).
 	progress.Stage(3, totalStages, fmt.Sprintf("Scanning %d ports across %d hosts...", len(cfg.Ports), liveHostCount))
-	openPorts, err := stages.Scanner.Scan(ctx, dnsRecords, cfg.Ports, cfg.Concurrency, cfg.Timeout)
+	obs.StageStarted("ports")
+	stageStart := time.Now()
+	var openPorts []PortResult
+	var err error
+	if resumable, ok := stages.Scanner.(ResumableScanner); ok && prior != nil && prior.OpenPorts != nil {
+		progress.Detail(fmt.Sprintf("Resuming from checkpoint: reusing %d open ports", len(prior.OpenPorts)))
+		openPorts, err = resumable.ResumeScan(ctx, dnsRecords, cfg.Ports, cfg.Concurrency, cfg.Timeout, prior.OpenPorts)
+	} else {
+		openPorts, err = stages.Scanner.Scan(ctx, dnsRecords, cfg.Ports, cfg.Concurrency, cfg.Timeout)
+	}
+	stageErrs := 0
 	if err != nil {
 		progress.Warn(fmt.Sprintf("Port scan error: %s", err))
+		stageErrs = 1
 	}
 	result.OpenPorts = openPorts
+	if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+		progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+	}
+	for _, p := range openPorts {
+		obs.PortOpen(p.Host, p.IP, p.Port)
+	}
+	obs.StageCompleted("ports", time.Since(stageStart), len(openPorts), stageErrs)
 	progress.Detail(fmt.Sprintf("Found %d open ports", len(openPorts)))
 
 	if len(openPorts) == 0 {
@@ -105,6 +269,9 @@ func Run(ctx context.Context, cfg Config, stages Stages, progress ProgressReport
 		result.CompletedAt = time.Now()
 		result.DurationSecs = result.CompletedAt.Sub(result.StartedAt).Seconds()
 		result.Summary = buildSummary(result)
+		if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+			progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+		}
 		return result, nil
 	}
 
@@ -114,33 +281,113 @@ func Run(ctx context.Context, cfg Config, stages Stages, progress ProgressReport
 		httpConcurrency = 1
 	}
 	progress.Stage(4, totalStages, fmt.Sprintf("Probing %d open ports for HTTP services...", len(openPorts)))
+	obs.StageStarted("http")
+	stageStart = time.Now()
 	services, err := stages.Prober.Probe(ctx, openPorts, httpConcurrency, cfg.Timeout)
+	stageErrs = 0
 	if err != nil {
 		progress.Warn(fmt.Sprintf("HTTP probe error: %s", err))
+		stageErrs = 1
 	}
 	result.HTTPServices = services
+	for _, svc := range services {
+		obs.HTTPServiceFound(svc)
+	}
+	obs.StageCompleted("http", time.Since(stageStart), len(services), stageErrs)
 	progress.Detail(fmt.Sprintf("Found %d HTTP services", len(services)))
 
+	// Feed TLS certificate SANs back into the subdomain set. This is purely
+	// informational for the current run (discovered too late to flow
+	// through DNS/port-scan/HTTP-probe itself) but surfaces pivot targets
+	// for the next sweep.
+	if newHosts := newSANSubdomains(result.Subdomains, services); len(newHosts) > 0 {
+		result.Subdomains = append(result.Subdomains, newHosts...)
+		for _, s := range newHosts {
+			obs.SubdomainDiscovered(s.Host, s.Sources)
+		}
+		progress.Detail(fmt.Sprintf("%d additional hostnames discovered via TLS cert SANs", len(newHosts)))
+	}
+
+	if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+		progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+	}
+
 	// Stage 5: Tech fingerprinting.
 	if len(services) > 0 {
 		progress.Stage(5, totalStages, "Fingerprinting technologies...")
+		obs.StageStarted("fingerprint")
+		stageStart = time.Now()
 		stages.Fingerprinter.Fingerprint(services)
 
 		techCount := 0
 		for _, svc := range services {
 			techCount += len(svc.Technologies)
+			for _, t := range svc.Technologies {
+				obs.TechIdentified(svc.URL, t)
+			}
 		}
+		obs.StageCompleted("fingerprint", time.Since(stageStart), techCount, 0)
 		result.HTTPServices = services
 		progress.Detail(fmt.Sprintf("Identified %d technology instances", techCount))
 	}
 
+	// Stage 6: Templated vulnerability checks.
+	if stages.VulnScanner != nil && len(services) > 0 {
+		progress.Stage(6, totalStages, "Running vulnerability checks...")
+		obs.StageStarted("vulnscan")
+		stageStart = time.Now()
+		findings, err := stages.VulnScanner.Scan(ctx, services, cfg.Concurrency, cfg.Timeout)
+		stageErrs = 0
+		if err != nil {
+			progress.Warn(fmt.Sprintf("vulnerability scan error: %s", err))
+			stageErrs = 1
+		}
+		result.Findings = findings
+		for _, f := range findings {
+			obs.VulnFindingDiscovered(f)
+		}
+		obs.StageCompleted("vulnscan", time.Since(stageStart), len(findings), stageErrs)
+		progress.Detail(fmt.Sprintf("Found %d findings", len(findings)))
+		if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+			progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+		}
+	}
+
 	result.CompletedAt = time.Now()
 	result.DurationSecs = result.CompletedAt.Sub(result.StartedAt).Seconds()
 	result.Summary = buildSummary(result)
+	if err := saveCheckpoint(cfg.Checkpoint, result); err != nil {
+		progress.Warn(fmt.Sprintf("checkpoint: %s", err))
+	}
 
 	return result, nil
 }
 
+// newSANSubdomains returns Subdomains, tagged with source "tls-san", for
+// any DNS name in a probed HTTPS service's certificate SANs that isn't
+// already present in known.
+func newSANSubdomains(known []Subdomain, services []HTTPService) []Subdomain {
+	seen := make(map[string]bool, len(known))
+	for _, s := range known {
+		seen[s.Host] = true
+	}
+
+	var added []Subdomain
+	for _, svc := range services {
+		if svc.TLSCert == nil {
+			continue
+		}
+		for _, san := range svc.TLSCert.SANs {
+			if seen[san] {
+				continue
+			}
+			seen[san] = true
+			added = append(added, Subdomain{Host: san, Sources: []string{"tls-san"}})
+		}
+	}
+	return added
+}
+
 func buildSummary(result *ScanResult) Summary {
 	liveHosts := make(map[string]bool)
 	for _, r := range result.DNSRecords {
@@ -171,5 +418,7 @@ func buildSummary(result *ScanResult) Summary {
 		TechCount:         len(techSet),
 		DanglingCNAMEs:    len(result.DanglingCNAMEs),
 		ZoneTransferCount: zoneTransferCount,
+		DNSFindings:       len(result.DNSFindings),
+		FindingCount:      len(result.Findings),
 	}
 }
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -29,24 +30,35 @@ func (m *mockEnumerator) GetWarnings() []string {
 }
 
 type mockResolver struct {
-	records   []DNSResult
-	dangling  []DanglingCNAME
-	err       error
+	records  []DNSResult
+	dangling []DanglingCNAME
+	err      error
+	findings []DNSFinding
 }
 
-func (m *mockResolver) Resolve(ctx context.Context, hosts []string, concurrency int) ([]DNSResult, []DanglingCNAME, error) {
+func (m *mockResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]DNSResult, []DanglingCNAME, error) {
 	return m.records, m.dangling, m.err
 }
 
+func (m *mockResolver) GetDNSFindings() []DNSFinding {
+	return m.findings
+}
+
 type mockScanner struct {
-	ports []PortResult
-	err   error
+	ports       []PortResult
+	err         error
+	resumeCalls int
 }
 
 func (m *mockScanner) Scan(ctx context.Context, dnsRecords []DNSResult, ports []int, concurrency int, timeout time.Duration) ([]PortResult, error) {
 	return m.ports, m.err
 }
 
+func (m *mockScanner) ResumeScan(ctx context.Context, dnsRecords []DNSResult, ports []int, concurrency int, timeout time.Duration, prior []PortResult) ([]PortResult, error) {
+	m.resumeCalls++
+	return append(append([]PortResult{}, prior...), m.ports...), m.err
+}
+
 type mockProber struct {
 	services []HTTPService
 	err      error
@@ -72,6 +84,26 @@ func (p *noopProgress) Stage(num, total int, msg string) {}
 func (p *noopProgress) Detail(msg string)                {}
 func (p *noopProgress) Warn(msg string)                  {}
 
+type recordingObserver struct {
+	stagesCompleted []string
+	subdomains      []string
+	portsOpen       int
+}
+
+func (o *recordingObserver) StageStarted(stage string) {}
+func (o *recordingObserver) StageCompleted(stage string, duration time.Duration, count, errors int) {
+	o.stagesCompleted = append(o.stagesCompleted, stage)
+}
+func (o *recordingObserver) SubdomainDiscovered(host string, sources []string) {
+	o.subdomains = append(o.subdomains, host)
+}
+func (o *recordingObserver) HostResolved(host string, ips []string)                           {}
+func (o *recordingObserver) PortOpen(host, ip string, port int)                               { o.portsOpen++ }
+func (o *recordingObserver) HTTPServiceFound(svc HTTPService)                                 {}
+func (o *recordingObserver) TechIdentified(url string, tech Technology)                       {}
+func (o *recordingObserver) ZoneTransferAttempt(nameserver string, success bool, records int) {}
+func (o *recordingObserver) VulnFindingDiscovered(finding Finding)                            {}
+
 func TestEngine_FullPipeline(t *testing.T) {
 	stages := Stages{
 		Enumerator: &mockEnumerator{
@@ -150,6 +182,47 @@ func TestEngine_FullPipeline(t *testing.T) {
 	}
 }
 
+func TestEngine_EmitsObserverEvents(t *testing.T) {
+	stages := Stages{
+		Enumerator: &mockEnumerator{
+			subdomains: []Subdomain{{Host: "example.com", Sources: []string{"root"}}},
+		},
+		Resolver: &mockResolver{
+			records: []DNSResult{{Host: "example.com", IPs: []string{"1.2.3.4"}}},
+		},
+		Scanner: &mockScanner{
+			ports: []PortResult{{Host: "example.com", IP: "1.2.3.4", Port: 443}},
+		},
+		Prober: &mockProber{
+			services: []HTTPService{{URL: "https://example.com:443", Host: "example.com", StatusCode: 200}},
+		},
+		Fingerprinter: &mockFingerprinter{},
+	}
+
+	obs := &recordingObserver{}
+	cfg := Config{Target: "example.com", Ports: []int{443}, Timeout: time.Second, Concurrency: 5, Observer: obs}
+
+	if _, err := Run(context.Background(), cfg, stages, &noopProgress{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantStages := []string{"subdomains", "dns", "ports", "http", "fingerprint"}
+	if len(obs.stagesCompleted) != len(wantStages) {
+		t.Fatalf("stages completed = %v, want %v", obs.stagesCompleted, wantStages)
+	}
+	for i, stage := range wantStages {
+		if obs.stagesCompleted[i] != stage {
+			t.Errorf("stage %d = %q, want %q", i, obs.stagesCompleted[i], stage)
+		}
+	}
+	if len(obs.subdomains) != 1 || obs.subdomains[0] != "example.com" {
+		t.Errorf("subdomains observed = %v, want [example.com]", obs.subdomains)
+	}
+	if obs.portsOpen != 1 {
+		t.Errorf("ports open observed = %d, want 1", obs.portsOpen)
+	}
+}
+
 func TestEngine_NoSubdomains_ReturnsError(t *testing.T) {
 	stages := Stages{
 		Enumerator: &mockEnumerator{
@@ -253,6 +326,122 @@ func TestEngine_ZoneTransfers(t *testing.T) {
 	}
 }
 
+func TestEngine_DNSFindings_FlowIntoWarningsAndSummary(t *testing.T) {
+	stages := Stages{
+		Enumerator: &mockEnumerator{
+			subdomains: []Subdomain{{Host: "example.com", Sources: []string{"root"}}},
+		},
+		Resolver: &mockResolver{
+			records: []DNSResult{{Host: "example.com", IPs: []string{"1.2.3.4"}}},
+			findings: []DNSFinding{
+				{Host: "example.com", Category: "spf", Severity: "medium", Detail: "no SPF record found"},
+				{Host: "example.com", Category: "dmarc", Severity: "high", Detail: "no DMARC record found"},
+			},
+		},
+		Scanner: &mockScanner{
+			ports: []PortResult{{Host: "example.com", IP: "1.2.3.4", Port: 443}},
+		},
+		Prober: &mockProber{
+			services: []HTTPService{{URL: "https://example.com:443", Host: "example.com", StatusCode: 200}},
+		},
+		Fingerprinter: &mockFingerprinter{},
+	}
+
+	cfg := Config{Target: "example.com", Ports: []int{443}, Timeout: time.Second, Concurrency: 5}
+	result, err := Run(context.Background(), cfg, stages, &noopProgress{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Summary.DNSFindings != 2 {
+		t.Errorf("summary DNS findings = %d, want 2", result.Summary.DNSFindings)
+	}
+	if len(result.Warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %d: %v", len(result.Warnings), result.Warnings)
+	}
+}
+
+func TestEngine_Checkpoint_ResumesCompletedStages(t *testing.T) {
+	checkpoint := filepath.Join(t.TempDir(), "scan.json")
+
+	enumerator := &mockEnumerator{
+		subdomains: []Subdomain{{Host: "example.com", Sources: []string{"root"}}},
+	}
+	stages := Stages{
+		Enumerator: enumerator,
+		Resolver: &mockResolver{
+			records: []DNSResult{{Host: "example.com", IPs: []string{"1.2.3.4"}}},
+		},
+		Scanner: &mockScanner{
+			ports: []PortResult{{Host: "example.com", IP: "1.2.3.4", Port: 443}},
+		},
+		Prober: &mockProber{
+			services: []HTTPService{{URL: "https://example.com:443", Host: "example.com", StatusCode: 200}},
+		},
+		Fingerprinter: &mockFingerprinter{},
+	}
+
+	cfg := Config{Target: "example.com", Ports: []int{443}, Timeout: time.Second, Concurrency: 5, Checkpoint: checkpoint}
+	if _, err := Run(context.Background(), cfg, stages, &noopProgress{}); err != nil {
+		t.Fatalf("first run: unexpected error: %v", err)
+	}
+
+	// A second run against the same target and checkpoint should reuse the
+	// prior subdomains and DNS records instead of calling those stages
+	// again.
+	enumerator.err = fmt.Errorf("enumeration should not run on resume")
+	stages.Resolver = &mockResolver{err: fmt.Errorf("resolution should not run on resume")}
+
+	result, err := Run(context.Background(), cfg, stages, &noopProgress{})
+	if err != nil {
+		t.Fatalf("resumed run: unexpected error: %v", err)
+	}
+	if len(result.Subdomains) != 1 || result.Subdomains[0].Host != "example.com" {
+		t.Errorf("resumed subdomains = %v, want [example.com]", result.Subdomains)
+	}
+	if len(result.DNSRecords) != 1 {
+		t.Errorf("resumed dns records = %d, want 1", len(result.DNSRecords))
+	}
+}
+
+func TestEngine_Checkpoint_ResumableScannerReusesOpenPorts(t *testing.T) {
+	checkpoint := filepath.Join(t.TempDir(), "scan.json")
+
+	scanner := &mockScanner{
+		ports: []PortResult{{Host: "example.com", IP: "1.2.3.4", Port: 443}},
+	}
+	stages := Stages{
+		Enumerator: &mockEnumerator{
+			subdomains: []Subdomain{{Host: "example.com", Sources: []string{"root"}}},
+		},
+		Resolver: &mockResolver{
+			records: []DNSResult{{Host: "example.com", IPs: []string{"1.2.3.4"}}},
+		},
+		Scanner: scanner,
+		Prober: &mockProber{
+			services: []HTTPService{{URL: "https://example.com:443", Host: "example.com", StatusCode: 200}},
+		},
+		Fingerprinter: &mockFingerprinter{},
+	}
+
+	cfg := Config{Target: "example.com", Ports: []int{443}, Timeout: time.Second, Concurrency: 5, Checkpoint: checkpoint}
+	if _, err := Run(context.Background(), cfg, stages, &noopProgress{}); err != nil {
+		t.Fatalf("first run: unexpected error: %v", err)
+	}
+
+	scanner.ports = []PortResult{{Host: "example.com", IP: "1.2.3.4", Port: 8443}}
+	result, err := Run(context.Background(), cfg, stages, &noopProgress{})
+	if err != nil {
+		t.Fatalf("resumed run: unexpected error: %v", err)
+	}
+	if scanner.resumeCalls != 1 {
+		t.Errorf("resumeCalls = %d, want 1", scanner.resumeCalls)
+	}
+	if len(result.OpenPorts) != 2 {
+		t.Errorf("resumed open ports = %d, want 2 (prior + new)", len(result.OpenPorts))
+	}
+}
+
 func TestEngine_DanglingCNAMEs(t *testing.T) {
 	stages := Stages{
 		Enumerator: &mockEnumerator{
@@ -0,0 +1,21 @@
+package engine
+
+import "time"
+
+// Observer receives structured, machine-readable events for each pipeline
+// step, in parallel with the human-oriented ProgressReporter. Unlike
+// ProgressReporter's free-form strings, Observer events carry typed fields
+// so CI tooling and metrics backends can consume them without regex hacks.
+// All methods are optional in spirit — callers that don't care about a
+// given event can leave the corresponding method a no-op.
+type Observer interface {
+	StageStarted(stage string)
+	StageCompleted(stage string, duration time.Duration, count, errors int)
+	SubdomainDiscovered(host string, sources []string)
+	HostResolved(host string, ips []string)
+	PortOpen(host, ip string, port int)
+	HTTPServiceFound(svc HTTPService)
+	TechIdentified(url string, tech Technology)
+	ZoneTransferAttempt(nameserver string, success bool, records int)
+	VulnFindingDiscovered(finding Finding)
+}
@@ -8,7 +8,12 @@ import (
 
 // ScanResult is the top-level output of a sweep run.
 type ScanResult struct {
-	Target         string          `json:"target"`
+	Target string `json:"target"`
+	// OriginTarget is the literal input (a CIDR block, an ASN, or a line
+	// from --list) this run's Target was expanded from. Empty when Target
+	// was given directly, so single-target runs keep their existing JSON
+	// shape.
+	OriginTarget   string          `json:"origin_target,omitempty"`
 	StartedAt      time.Time       `json:"started_at"`
 	CompletedAt    time.Time       `json:"completed_at"`
 	DurationSecs   float64         `json:"duration_secs"`
@@ -18,6 +23,8 @@ type ScanResult struct {
 	HTTPServices   []HTTPService   `json:"http_services"`
 	DanglingCNAMEs []DanglingCNAME `json:"dangling_cnames,omitempty"`
 	ZoneTransfers  []ZoneTransfer  `json:"zone_transfers,omitempty"`
+	DNSFindings    []DNSFinding    `json:"dns_findings,omitempty"`
+	Findings       []Finding       `json:"findings,omitempty"`
 	Warnings       []string        `json:"warnings,omitempty"`
 	Summary        Summary         `json:"summary"`
 }
@@ -30,9 +37,44 @@ type Subdomain struct {
 
 // DNSResult holds resolved DNS records for a host.
 type DNSResult struct {
-	Host  string   `json:"host"`
-	IPs   []string `json:"ips"`
-	CNAME string   `json:"cname,omitempty"`
+	Host     string      `json:"host"`
+	IPs      []string    `json:"ips"`
+	CNAME    string      `json:"cname,omitempty"`
+	MX       []MXRecord  `json:"mx,omitempty"`
+	NS       []string    `json:"ns,omitempty"`
+	TXT      []string    `json:"txt,omitempty"`
+	CAA      []CAARecord `json:"caa,omitempty"`
+	SRV      []SRVRecord `json:"srv,omitempty"`
+	Resolver string      `json:"resolver,omitempty"` // upstream that answered, e.g. "tls://8.8.8.8:853" or "system"
+}
+
+// MXRecord is a mail exchange record.
+type MXRecord struct {
+	Host     string `json:"host"`
+	Priority int    `json:"priority"`
+}
+
+// SRVRecord is a service (SRV) record.
+type SRVRecord struct {
+	Target   string `json:"target"`
+	Port     int    `json:"port"`
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+}
+
+// CAARecord is a certification authority authorization record.
+type CAARecord struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+// DNSFinding is a derived security observation about a domain's DNS
+// posture (SPF/DMARC/DKIM/CAA/MX/NS), as opposed to a raw record.
+type DNSFinding struct {
+	Host     string `json:"host"`
+	Category string `json:"category"` // e.g. "spf", "dmarc", "dkim", "caa", "wildcard-mx", "ns-unreachable"
+	Severity string `json:"severity"` // "info", "low", "medium", "high"
+	Detail   string `json:"detail"`
 }
 
 // PortResult represents an open port on a host.
@@ -54,19 +96,65 @@ type HTTPService struct {
 	Server        string       `json:"server,omitempty"`
 	ContentLength int64        `json:"content_length"`
 	Technologies  []Technology `json:"technologies,omitempty"`
+
+	// FaviconHash is the httpx/Shodan-compatible favicon hash: the
+	// favicon's raw bytes, base64-encoded, hashed with 32-bit MurmurHash3
+	// (seed 0). Lets users pivot to other hosts serving the same app.
+	// Empty if no favicon could be fetched.
+	FaviconHash string `json:"favicon_hash,omitempty"`
+	// TLSCert is the leaf certificate presented during the handshake.
+	// Nil for http:// services.
+	TLSCert *TLSCertInfo `json:"tls_cert,omitempty"`
+	// JARM is the TLS server fingerprint (ten varied ClientHellos,
+	// hashed in order). Only populated when requested, since it costs
+	// ten extra handshakes per target. Empty for http:// services.
+	JARM string `json:"jarm,omitempty"`
+}
+
+// TLSCertInfo holds identifying details from an HTTPS target's leaf
+// certificate.
+type TLSCertInfo struct {
+	CommonName string    `json:"common_name,omitempty"`
+	SANs       []string  `json:"sans,omitempty"`
+	Issuer     string    `json:"issuer,omitempty"`
+	NotBefore  time.Time `json:"not_before"`
+	NotAfter   time.Time `json:"not_after"`
 }
 
 // Technology represents a detected technology.
 type Technology struct {
 	Name     string `json:"name"`
 	Category string `json:"category"`
+	Version  string `json:"version,omitempty"`
+}
+
+// DanglingCNAME represents a potential subdomain takeover candidate. Status
+// starts as "NXDOMAIN" or "SERVFAIL" from raw DNS resolution; a
+// TakeoverVerifier that recognizes the CNAME target's service upgrades it
+// to "confirmed", "vulnerable", or "unclaimed" and populates Service,
+// Severity, and Evidence. Confirmed is true once a verifier has actually
+// matched the provider's takeover fingerprint (or the provider's
+// nxdomain_only rule means the dangling CNAME alone is sufficient
+// evidence) — false means the CNAME merely matches a known pattern, i.e.
+// it's suspected but not yet verified.
+type DanglingCNAME struct {
+	Host      string `json:"host"`
+	CNAME     string `json:"cname"`
+	Status    string `json:"status"`
+	Confirmed bool   `json:"confirmed"`
+	Service   string `json:"service,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Evidence  string `json:"evidence,omitempty"`
 }
 
-// DanglingCNAME represents a potential subdomain takeover candidate.
-type DanglingCNAME struct {
-	Host   string `json:"host"`
-	CNAME  string `json:"cname"`
-	Status string `json:"status"`
+// Finding is a matched templated vulnerability check against an HTTPService,
+// in the spirit of a minimal nuclei-template result.
+type Finding struct {
+	Template  string `json:"template"`
+	Name      string `json:"name,omitempty"`
+	Severity  string `json:"severity"`
+	MatchedAt string `json:"matched_at"`
+	CVE       string `json:"cve,omitempty"`
 }
 
 // ZoneTransfer represents the result of an AXFR attempt against a nameserver.
@@ -85,6 +173,8 @@ type Summary struct {
 	TechCount         int `json:"tech_count"`
 	DanglingCNAMEs    int `json:"dangling_cnames"`
 	ZoneTransferCount int `json:"zone_transfers"`
+	DNSFindings       int `json:"dns_findings"`
+	FindingCount      int `json:"finding_count"`
 }
 
 // SubdomainEnumerator discovers subdomains for a domain.
@@ -94,7 +184,7 @@ type SubdomainEnumerator interface {
 
 // DNSResolver resolves hostnames to IPs and detects dangling CNAMEs.
 type DNSResolver interface {
-	Resolve(ctx context.Context, hosts []string, concurrency int) ([]DNSResult, []DanglingCNAME, error)
+	Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]DNSResult, []DanglingCNAME, error)
 }
 
 // PortScanner scans for open TCP ports.
@@ -102,6 +192,12 @@ type PortScanner interface {
 	Scan(ctx context.Context, dnsRecords []DNSResult, ports []int, concurrency int, timeout time.Duration) ([]PortResult, error)
 }
 
+// Port scan modes for Config.ScanMode.
+const (
+	ScanModeConnect = "connect" // full TCP handshake (default)
+	ScanModeSYN     = "syn"     // half-open SYN scan; falls back to connect if unavailable
+)
+
 // HTTPProber probes open ports for HTTP services.
 type HTTPProber interface {
 	Probe(ctx context.Context, targets []PortResult, concurrency int, timeout time.Duration) ([]HTTPService, error)
@@ -112,6 +208,21 @@ type TechFingerprinter interface {
 	Fingerprint(services []HTTPService) // mutates in place
 }
 
+// VulnScanner runs lightweight templated vulnerability checks (matchers on
+// status/headers/body, gated by the technologies a service already
+// fingerprinted) against probed HTTP services.
+type VulnScanner interface {
+	Scan(ctx context.Context, services []HTTPService, concurrency int, timeout time.Duration) ([]Finding, error)
+}
+
+// TakeoverVerifier enriches dangling-CNAME candidates by matching their
+// CNAME target against a database of known SaaS takeover fingerprints and,
+// where applicable, probing the host over HTTP to confirm the service is
+// actually unclaimed.
+type TakeoverVerifier interface {
+	Verify(ctx context.Context, candidates []DanglingCNAME, concurrency int, timeout time.Duration) ([]DanglingCNAME, error)
+}
+
 // ZoneTransferProvider is an optional interface that SubdomainEnumerator
 // implementations can satisfy to report zone transfer results.
 type ZoneTransferProvider interface {
@@ -123,3 +234,10 @@ type ZoneTransferProvider interface {
 type WarningProvider interface {
 	GetWarnings() []string
 }
+
+// DNSFindingsProvider is an optional interface a DNSResolver can satisfy
+// to report derived DNS security findings (SPF/DMARC/DKIM/CAA/NS issues)
+// alongside the raw records returned from Resolve.
+type DNSFindingsProvider interface {
+	GetDNSFindings() []DNSFinding
+}
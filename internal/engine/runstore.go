@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultRunStoreDir returns the default base directory for persisted run
+// history: ~/.local/state/sweep. Falls back to "./.sweep-state" if the
+// home directory can't be determined.
+func DefaultRunStoreDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".sweep-state"
+	}
+	return filepath.Join(home, ".local", "state", "sweep")
+}
+
+// RunStore persists a ScanResult per (target, run ID) under BaseDir, plus
+// a "latest" pointer recording the most recently completed run per
+// target. It's the same checkpoint file format Config.Checkpoint writes —
+// a RunStore just gives each run a stable, addressable path instead of
+// requiring the caller to pick one, so --resume and --diff have
+// somewhere to find prior runs without the user tracking file paths by
+// hand.
+type RunStore struct {
+	BaseDir string
+}
+
+// NewRunStore returns a RunStore rooted at baseDir. An empty baseDir uses
+// DefaultRunStoreDir().
+func NewRunStore(baseDir string) *RunStore {
+	if baseDir == "" {
+		baseDir = DefaultRunStoreDir()
+	}
+	return &RunStore{BaseDir: baseDir}
+}
+
+// targetDir returns the directory holding target's run history, sanitizing
+// it so a target containing path separators can't escape BaseDir.
+func (s *RunStore) targetDir(target string) string {
+	safe := strings.NewReplacer("/", "_", "\\", "_").Replace(target)
+	return filepath.Join(s.BaseDir, safe)
+}
+
+// RunPath returns the checkpoint file path for a given (target, runID).
+func (s *RunStore) RunPath(target, runID string) string {
+	return filepath.Join(s.targetDir(target), runID+".json")
+}
+
+// NewRunID generates a new, sortable run ID for a fresh run.
+func (s *RunStore) NewRunID() string {
+	return time.Now().UTC().Format("20060102T150405Z")
+}
+
+// Load reads a previously persisted run. A missing run is not an error —
+// it returns (nil, nil).
+func (s *RunStore) Load(target, runID string) (*ScanResult, error) {
+	return loadCheckpoint(s.RunPath(target, runID))
+}
+
+func (s *RunStore) latestPath(target string) string {
+	return filepath.Join(s.targetDir(target), "latest")
+}
+
+// LatestRunID returns the run ID most recently marked complete for
+// target via SetLatestRunID, or "" if none exists yet.
+func (s *RunStore) LatestRunID(target string) (string, error) {
+	data, err := os.ReadFile(s.latestPath(target))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read latest run pointer: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetLatestRunID records runID as target's most recently completed run,
+// so the next invocation's --diff has a baseline to compare against.
+func (s *RunStore) SetLatestRunID(target, runID string) error {
+	dir := s.targetDir(target)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create run store dir: %w", err)
+	}
+	path := s.latestPath(target)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(runID), 0o644); err != nil {
+		return fmt.Errorf("write latest run pointer: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename latest run pointer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunStoreSaveLoadAndLatest(t *testing.T) {
+	dir := t.TempDir()
+	store := NewRunStore(dir)
+
+	runID := "20260101T000000Z"
+	target := "example.com"
+	if err := os.MkdirAll(filepath.Dir(store.RunPath(target, runID)), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	result := &ScanResult{Target: target, Subdomains: []Subdomain{{Host: "www.example.com"}}}
+	if err := saveCheckpoint(store.RunPath(target, runID), result); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	loaded, err := store.Load(target, runID)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if loaded == nil || loaded.Target != target {
+		t.Fatalf("got %+v", loaded)
+	}
+
+	if latest, err := store.LatestRunID(target); err != nil || latest != "" {
+		t.Fatalf("expected no latest run yet, got %q, err %v", latest, err)
+	}
+
+	if err := store.SetLatestRunID(target, runID); err != nil {
+		t.Fatalf("set latest: %v", err)
+	}
+	latest, err := store.LatestRunID(target)
+	if err != nil {
+		t.Fatalf("latest: %v", err)
+	}
+	if latest != runID {
+		t.Errorf("got %q, want %q", latest, runID)
+	}
+}
+
+func TestRunStoreLoadMissingIsNotAnError(t *testing.T) {
+	store := NewRunStore(t.TempDir())
+	result, err := store.Load("example.com", "does-not-exist")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != nil {
+		t.Errorf("got %+v, want nil", result)
+	}
+}
+
+func TestRunStoreTargetDirSanitizesSeparators(t *testing.T) {
+	store := NewRunStore(t.TempDir())
+	dir := store.targetDir("weird/../example.com")
+	if filepath.Dir(dir) != store.BaseDir {
+		t.Errorf("targetDir escaped BaseDir: %s", dir)
+	}
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// WriteDiffJSON writes a RunDiff as indented JSON to w.
+func WriteDiffJSON(w io.Writer, diff engine.RunDiff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diff)
+}
+
+// WriteDiff prints a RunDiff in human-readable form to w.
+func WriteDiff(w io.Writer, diff engine.RunDiff, noColor bool) {
+	if diff.Empty() {
+		fmt.Fprintln(w, "No changes since the previous run.")
+		return
+	}
+
+	bang := "!"
+	if !noColor {
+		bang = "\033[33m!\033[0m"
+	}
+
+	if len(diff.NewSubdomains) > 0 {
+		fmt.Fprintf(w, "%s %d new subdomains\n", bang, len(diff.NewSubdomains))
+		for _, host := range diff.NewSubdomains {
+			fmt.Fprintf(w, "  + %s\n", host)
+		}
+	}
+	if len(diff.NewOpenPorts) > 0 {
+		fmt.Fprintf(w, "%s %d newly open ports\n", bang, len(diff.NewOpenPorts))
+		for _, p := range diff.NewOpenPorts {
+			fmt.Fprintf(w, "  + %s:%d\n", p.Host, p.Port)
+		}
+	}
+	if len(diff.ChangedTech) > 0 {
+		fmt.Fprintf(w, "%s %d services with a changed tech stack\n", bang, len(diff.ChangedTech))
+		for _, c := range diff.ChangedTech {
+			fmt.Fprintf(w, "  ~ %s: %v -> %v\n", c.URL, c.Before, c.After)
+		}
+	}
+	if len(diff.DisappearedServices) > 0 {
+		fmt.Fprintf(w, "%s %d services no longer responding\n", bang, len(diff.DisappearedServices))
+		for _, url := range diff.DisappearedServices {
+			fmt.Fprintf(w, "  - %s\n", url)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// Formatter renders a ScanResult in some output format.
+type Formatter interface {
+	Format(w io.Writer, r *engine.ScanResult) error
+}
+
+// FormatterFactory constructs a Formatter. Implementations register a
+// factory under a name via Register, typically from an init() in the
+// file that defines the formatter.
+type FormatterFactory func() Formatter
+
+var formatters = make(map[string]FormatterFactory)
+
+// Register adds a named formatter factory, for lookup by NewFormatter
+// (and by the CLI's --output flag).
+func Register(name string, factory FormatterFactory) {
+	formatters[name] = factory
+}
+
+// NewFormatter looks up a registered formatter by name.
+func NewFormatter(name string) (Formatter, error) {
+	factory, ok := formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q", name)
+	}
+	return factory(), nil
+}
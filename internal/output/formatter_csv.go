@@ -0,0 +1,60 @@
+package output
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func init() {
+	Register("csv", func() Formatter { return &csvFormatter{} })
+}
+
+// csvFormatter renders every record type as a single flat rowset, with a
+// "type" column distinguishing subdomain/port/http_service/dangling_cname/
+// finding rows; columns that don't apply to a given row type are left blank.
+type csvFormatter struct{}
+
+var csvColumns = []string{"type", "host", "sources", "ip", "port", "url", "status_code", "title", "cname", "status", "confirmed", "service", "severity", "evidence", "template", "cve"}
+
+func (f *csvFormatter) Format(w io.Writer, r *engine.ScanResult) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, s := range r.Subdomains {
+		if err := cw.Write([]string{"subdomain", s.Host, strings.Join(s.Sources, ";"), "", "", "", "", "", "", "", "", "", "", "", "", ""}); err != nil {
+			return err
+		}
+	}
+	for _, p := range r.OpenPorts {
+		if err := cw.Write([]string{"port", p.Host, "", p.IP, strconv.Itoa(p.Port), "", "", "", "", "", "", "", "", "", "", ""}); err != nil {
+			return err
+		}
+	}
+	for _, svc := range r.HTTPServices {
+		row := []string{"http_service", svc.Host, "", svc.IP, strconv.Itoa(svc.Port), svc.URL, strconv.Itoa(svc.StatusCode), svc.Title, "", "", "", "", "", "", "", ""}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	for _, dc := range r.DanglingCNAMEs {
+		row := []string{"dangling_cname", dc.Host, "", "", "", "", "", "", dc.CNAME, dc.Status, strconv.FormatBool(dc.Confirmed), dc.Service, dc.Severity, dc.Evidence, "", ""}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	for _, f := range r.Findings {
+		row := []string{"finding", "", "", "", "", f.MatchedAt, "", f.Name, "", "", "", "", f.Severity, "", f.Template, f.CVE}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,95 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func init() {
+	Register("jsonl", func() Formatter { return &jsonlFormatter{} })
+}
+
+// jsonlFormatter writes one JSON line per Subdomain, PortResult,
+// HTTPService, DanglingCNAME, and Finding, for streaming ingestion into
+// log pipelines rather than parsing the full nested ScanResult.
+type jsonlFormatter struct{}
+
+type jsonlSubdomainRow struct {
+	Type    string   `json:"type"`
+	Host    string   `json:"host"`
+	Sources []string `json:"sources,omitempty"`
+}
+
+type jsonlPortRow struct {
+	Type string `json:"type"`
+	Host string `json:"host"`
+	IP   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+type jsonlHTTPServiceRow struct {
+	Type       string `json:"type"`
+	Host       string `json:"host"`
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+	Title      string `json:"title,omitempty"`
+}
+
+type jsonlDanglingCNAMERow struct {
+	Type      string `json:"type"`
+	Host      string `json:"host"`
+	CNAME     string `json:"cname"`
+	Status    string `json:"status"`
+	Confirmed bool   `json:"confirmed"`
+	Service   string `json:"service,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Evidence  string `json:"evidence,omitempty"`
+}
+
+type jsonlFindingRow struct {
+	Type      string `json:"type"`
+	Template  string `json:"template"`
+	Name      string `json:"name,omitempty"`
+	Severity  string `json:"severity"`
+	MatchedAt string `json:"matched_at"`
+	CVE       string `json:"cve,omitempty"`
+}
+
+func (f *jsonlFormatter) Format(w io.Writer, r *engine.ScanResult) error {
+	enc := json.NewEncoder(w)
+
+	for _, s := range r.Subdomains {
+		if err := enc.Encode(jsonlSubdomainRow{Type: "subdomain", Host: s.Host, Sources: s.Sources}); err != nil {
+			return err
+		}
+	}
+	for _, p := range r.OpenPorts {
+		if err := enc.Encode(jsonlPortRow{Type: "port", Host: p.Host, IP: p.IP, Port: p.Port}); err != nil {
+			return err
+		}
+	}
+	for _, svc := range r.HTTPServices {
+		if err := enc.Encode(jsonlHTTPServiceRow{Type: "http_service", Host: svc.Host, URL: svc.URL, StatusCode: svc.StatusCode, Title: svc.Title}); err != nil {
+			return err
+		}
+	}
+	for _, dc := range r.DanglingCNAMEs {
+		row := jsonlDanglingCNAMERow{
+			Type: "dangling_cname", Host: dc.Host, CNAME: dc.CNAME, Status: dc.Status, Confirmed: dc.Confirmed,
+			Service: dc.Service, Severity: dc.Severity, Evidence: dc.Evidence,
+		}
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	for _, f := range r.Findings {
+		row := jsonlFindingRow{Type: "finding", Template: f.Template, Name: f.Name, Severity: f.Severity, MatchedAt: f.MatchedAt, CVE: f.CVE}
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
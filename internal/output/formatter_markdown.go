@@ -0,0 +1,71 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func init() {
+	Register("markdown", func() Formatter { return &markdownFormatter{} })
+}
+
+// markdownFormatter renders a GitHub-flavored markdown summary, suitable
+// for posting as a PR comment.
+type markdownFormatter struct{}
+
+func (f *markdownFormatter) Format(w io.Writer, r *engine.ScanResult) error {
+	s := r.Summary
+	fmt.Fprintf(w, "### sweep scan: `%s`\n\n", r.Target)
+	fmt.Fprintln(w, "| Metric | Count |")
+	fmt.Fprintln(w, "| --- | --- |")
+	fmt.Fprintf(w, "| Subdomains found | %d |\n", s.SubdomainsFound)
+	fmt.Fprintf(w, "| Live hosts | %d |\n", s.LiveHosts)
+	fmt.Fprintf(w, "| Open ports | %d |\n", s.OpenPortCount)
+	fmt.Fprintf(w, "| HTTP services | %d |\n", s.HTTPServiceCount)
+	fmt.Fprintf(w, "| Technologies identified | %d |\n", s.TechCount)
+	fmt.Fprintf(w, "| Dangling CNAMEs | %d |\n", s.DanglingCNAMEs)
+	fmt.Fprintf(w, "| Zone transfers allowed | %d |\n", s.ZoneTransferCount)
+	fmt.Fprintf(w, "| DNS findings | %d |\n", s.DNSFindings)
+	fmt.Fprintf(w, "| Vulnerability findings | %d |\n", s.FindingCount)
+
+	if len(r.DanglingCNAMEs) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "**! Possible subdomain takeovers**")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "| Host | CNAME | Status | Verdict |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- |")
+		for _, dc := range r.DanglingCNAMEs {
+			verdict := "Suspected"
+			if dc.Confirmed {
+				verdict = "Confirmed"
+			}
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", dc.Host, dc.CNAME, dc.Status, verdict)
+		}
+	}
+
+	if len(r.DNSFindings) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "**! DNS findings**")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "| Host | Category | Severity | Detail |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- |")
+		for _, finding := range r.DNSFindings {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", finding.Host, finding.Category, finding.Severity, finding.Detail)
+		}
+	}
+
+	if len(r.Findings) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "**! Vulnerability findings**")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "| Template | Severity | Matched at | CVE |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- |")
+		for _, finding := range r.Findings {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", finding.Template, finding.Severity, finding.MatchedAt, finding.CVE)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func init() {
+	Register("sarif", func() Formatter { return &sarifFormatter{} })
+}
+
+// sarifFormatter renders a ScanResult as a minimal SARIF 2.1.0 log, mapping
+// dangling CNAMEs, DNS findings, and vulnerability findings to results so
+// they surface in GitHub code scanning / SIEM ingestion.
+type sarifFormatter struct{}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string      `json:"name"`
+	Version string      `json:"version"`
+	Rules   []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+func sarifLocationFor(host string) []sarifLocation {
+	return []sarifLocation{{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: host},
+		},
+	}}
+}
+
+func sarifSeverityLevel(severity string) string {
+	switch severity {
+	case "critical", "high":
+		return "error"
+	case "medium":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func (f *sarifFormatter) Format(w io.Writer, r *engine.ScanResult) error {
+	rules := []sarifRule{
+		{ID: "dangling-cname", Name: "DanglingCNAME"},
+	}
+	var results []sarifResult
+
+	for _, dc := range r.DanglingCNAMEs {
+		level := "warning"
+		verdict := "suspected"
+		if dc.Confirmed {
+			level = "error"
+			verdict = "confirmed"
+		}
+		results = append(results, sarifResult{
+			RuleID:    "dangling-cname",
+			Level:     level,
+			Message:   sarifMessage{Text: dc.Host + " -> " + dc.CNAME + " (" + dc.Status + ", " + verdict + "): possible subdomain takeover"},
+			Locations: sarifLocationFor(dc.Host),
+		})
+	}
+
+	seenRules := make(map[string]bool)
+	for _, finding := range r.DNSFindings {
+		ruleID := "dns-finding-" + finding.Category
+		if !seenRules[ruleID] {
+			seenRules[ruleID] = true
+			rules = append(rules, sarifRule{ID: ruleID, Name: "DNSFinding/" + finding.Category})
+		}
+		results = append(results, sarifResult{
+			RuleID:    ruleID,
+			Level:     sarifSeverityLevel(finding.Severity),
+			Message:   sarifMessage{Text: finding.Detail},
+			Locations: sarifLocationFor(finding.Host),
+		})
+	}
+
+	for _, finding := range r.Findings {
+		ruleID := "finding-" + finding.Template
+		if !seenRules[ruleID] {
+			seenRules[ruleID] = true
+			rules = append(rules, sarifRule{ID: ruleID, Name: finding.Name})
+		}
+		text := finding.Name
+		if finding.CVE != "" {
+			text += " (" + finding.CVE + ")"
+		}
+		results = append(results, sarifResult{
+			RuleID:    ruleID,
+			Level:     sarifSeverityLevel(finding.Severity),
+			Message:   sarifMessage{Text: text},
+			Locations: sarifLocationFor(finding.MatchedAt),
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:    "sweep",
+					Version: Version,
+					Rules:   rules,
+				},
+			},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
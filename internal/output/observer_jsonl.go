@@ -0,0 +1,91 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// JSONLObserver writes each engine.Observer event as a single JSON line to
+// w, for piping sweep's progress into other tools (log aggregators, CI
+// annotators) without scraping stderr strings.
+type JSONLObserver struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// NewJSONLObserver creates a JSONL event writer.
+func NewJSONLObserver(w io.Writer) *JSONLObserver {
+	return &JSONLObserver{w: w}
+}
+
+type jsonlEvent struct {
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Stage      string    `json:"stage,omitempty"`
+	Host       string    `json:"host,omitempty"`
+	IPs        []string  `json:"ips,omitempty"`
+	IP         string    `json:"ip,omitempty"`
+	Port       int       `json:"port,omitempty"`
+	Sources    []string  `json:"sources,omitempty"`
+	URL        string    `json:"url,omitempty"`
+	Tech       string    `json:"tech,omitempty"`
+	Version    string    `json:"version,omitempty"`
+	Nameserver string    `json:"nameserver,omitempty"`
+	Success    bool      `json:"success,omitempty"`
+	Records    int       `json:"records,omitempty"`
+	Status     int       `json:"status_code,omitempty"`
+	DurationS  float64   `json:"duration_secs,omitempty"`
+	Count      int       `json:"count,omitempty"`
+	Errors     int       `json:"errors,omitempty"`
+	Template   string    `json:"template,omitempty"`
+	Severity   string    `json:"severity,omitempty"`
+	CVE        string    `json:"cve,omitempty"`
+}
+
+func (j *JSONLObserver) write(e jsonlEvent) {
+	e.Time = time.Now()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	enc := json.NewEncoder(j.w)
+	_ = enc.Encode(e)
+}
+
+func (j *JSONLObserver) StageStarted(stage string) {
+	j.write(jsonlEvent{Type: "StageStarted", Stage: stage})
+}
+
+func (j *JSONLObserver) StageCompleted(stage string, duration time.Duration, count, errors int) {
+	j.write(jsonlEvent{Type: "StageCompleted", Stage: stage, DurationS: duration.Seconds(), Count: count, Errors: errors})
+}
+
+func (j *JSONLObserver) SubdomainDiscovered(host string, sources []string) {
+	j.write(jsonlEvent{Type: "SubdomainDiscovered", Host: host, Sources: sources})
+}
+
+func (j *JSONLObserver) HostResolved(host string, ips []string) {
+	j.write(jsonlEvent{Type: "HostResolved", Host: host, IPs: ips})
+}
+
+func (j *JSONLObserver) PortOpen(host, ip string, port int) {
+	j.write(jsonlEvent{Type: "PortOpen", Host: host, IP: ip, Port: port})
+}
+
+func (j *JSONLObserver) HTTPServiceFound(svc engine.HTTPService) {
+	j.write(jsonlEvent{Type: "HTTPService", Host: svc.Host, URL: svc.URL, Status: svc.StatusCode})
+}
+
+func (j *JSONLObserver) TechIdentified(url string, tech engine.Technology) {
+	j.write(jsonlEvent{Type: "TechIdentified", URL: url, Tech: tech.Name, Version: tech.Version})
+}
+
+func (j *JSONLObserver) ZoneTransferAttempt(nameserver string, success bool, records int) {
+	j.write(jsonlEvent{Type: "ZoneTransferAttempt", Nameserver: nameserver, Success: success, Records: records})
+}
+
+func (j *JSONLObserver) VulnFindingDiscovered(finding engine.Finding) {
+	j.write(jsonlEvent{Type: "VulnFindingDiscovered", URL: finding.MatchedAt, Template: finding.Template, Severity: finding.Severity, CVE: finding.CVE})
+}
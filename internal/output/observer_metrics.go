@@ -0,0 +1,105 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// MetricsObserver exposes a Prometheus /metrics endpoint tracking per-stage
+// durations and counters, for long-running or CI-embedded sweep runs that
+// need scrape-able progress instead of stderr strings.
+type MetricsObserver struct {
+	registry *prometheus.Registry
+	server   *http.Server
+
+	stageDuration   *prometheus.HistogramVec
+	portsOpenTotal  prometheus.Counter
+	httpStatusTotal *prometheus.CounterVec
+	subdomainsTotal prometheus.Counter
+	findingsTotal   *prometheus.CounterVec
+}
+
+// NewMetricsObserver creates the Prometheus collectors and starts an HTTP
+// server on addr serving /metrics. Call Close to shut the server down.
+func NewMetricsObserver(addr string) (*MetricsObserver, error) {
+	registry := prometheus.NewRegistry()
+
+	m := &MetricsObserver{
+		registry: registry,
+		stageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "sweep_stage_duration_seconds",
+			Help: "Duration of each sweep pipeline stage.",
+		}, []string{"stage"}),
+		portsOpenTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "sweep_ports_open_total",
+			Help: "Total open ports discovered.",
+		}),
+		httpStatusTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "sweep_http_status_total",
+			Help: "HTTP services discovered, labeled by status code.",
+		}, []string{"code"}),
+		subdomainsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "sweep_subdomains_total",
+			Help: "Total unique subdomains discovered.",
+		}),
+		findingsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "sweep_findings_total",
+			Help: "Vulnerability check findings, labeled by severity.",
+		}, []string{"severity"}),
+	}
+
+	registry.MustRegister(m.stageDuration, m.portsOpenTotal, m.httpStatusTotal, m.subdomainsTotal, m.findingsTotal)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	m.server = &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		_ = m.server.ListenAndServe()
+	}()
+
+	return m, nil
+}
+
+// Close shuts down the metrics HTTP server.
+func (m *MetricsObserver) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return m.server.Shutdown(ctx)
+}
+
+func (m *MetricsObserver) StageStarted(stage string) {}
+
+func (m *MetricsObserver) StageCompleted(stage string, duration time.Duration, count, errors int) {
+	m.stageDuration.WithLabelValues(stage).Observe(duration.Seconds())
+}
+
+func (m *MetricsObserver) SubdomainDiscovered(host string, sources []string) {
+	m.subdomainsTotal.Inc()
+}
+
+func (m *MetricsObserver) HostResolved(host string, ips []string) {}
+
+func (m *MetricsObserver) PortOpen(host, ip string, port int) {
+	m.portsOpenTotal.Inc()
+}
+
+func (m *MetricsObserver) HTTPServiceFound(svc engine.HTTPService) {
+	m.httpStatusTotal.WithLabelValues(strconv.Itoa(svc.StatusCode)).Inc()
+}
+
+func (m *MetricsObserver) TechIdentified(url string, tech engine.Technology) {}
+
+func (m *MetricsObserver) ZoneTransferAttempt(nameserver string, success bool, records int) {}
+
+func (m *MetricsObserver) VulnFindingDiscovered(finding engine.Finding) {
+	m.findingsTotal.WithLabelValues(finding.Severity).Inc()
+}
+
+var _ engine.Observer = (*MetricsObserver)(nil)
@@ -0,0 +1,65 @@
+package output
+
+import (
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// MultiObserver fans a single stream of engine.Observer events out to
+// several backends, e.g. a JSONLObserver and a MetricsObserver at once.
+type MultiObserver []engine.Observer
+
+func (m MultiObserver) StageStarted(stage string) {
+	for _, o := range m {
+		o.StageStarted(stage)
+	}
+}
+
+func (m MultiObserver) StageCompleted(stage string, duration time.Duration, count, errors int) {
+	for _, o := range m {
+		o.StageCompleted(stage, duration, count, errors)
+	}
+}
+
+func (m MultiObserver) SubdomainDiscovered(host string, sources []string) {
+	for _, o := range m {
+		o.SubdomainDiscovered(host, sources)
+	}
+}
+
+func (m MultiObserver) HostResolved(host string, ips []string) {
+	for _, o := range m {
+		o.HostResolved(host, ips)
+	}
+}
+
+func (m MultiObserver) PortOpen(host, ip string, port int) {
+	for _, o := range m {
+		o.PortOpen(host, ip, port)
+	}
+}
+
+func (m MultiObserver) HTTPServiceFound(svc engine.HTTPService) {
+	for _, o := range m {
+		o.HTTPServiceFound(svc)
+	}
+}
+
+func (m MultiObserver) TechIdentified(url string, tech engine.Technology) {
+	for _, o := range m {
+		o.TechIdentified(url, tech)
+	}
+}
+
+func (m MultiObserver) ZoneTransferAttempt(nameserver string, success bool, records int) {
+	for _, o := range m {
+		o.ZoneTransferAttempt(nameserver, success, records)
+	}
+}
+
+func (m MultiObserver) VulnFindingDiscovered(finding engine.Finding) {
+	for _, o := range m {
+		o.VulnFindingDiscovered(finding)
+	}
+}
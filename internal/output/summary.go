@@ -55,6 +55,18 @@ func WriteSummary(w io.Writer, result *engine.ScanResult, showCTA, noColor bool)
 		}
 	}
 
+	if s.DNSFindings > 0 {
+		fmt.Fprintln(w)
+		if noColor {
+			fmt.Fprintf(w, "! %d DNS findings (SPF/DMARC/DKIM/CAA/NS)\n", s.DNSFindings)
+		} else {
+			fmt.Fprintf(w, "\033[33m!\033[0m %d DNS findings (SPF/DMARC/DKIM/CAA/NS)\n", s.DNSFindings)
+		}
+		for _, f := range result.DNSFindings {
+			fmt.Fprintf(w, "  %s [%s/%s] %s\n", f.Host, f.Category, f.Severity, f.Detail)
+		}
+	}
+
 	if s.DanglingCNAMEs > 0 {
 		fmt.Fprintln(w)
 		if noColor {
@@ -63,7 +75,31 @@ func WriteSummary(w io.Writer, result *engine.ScanResult, showCTA, noColor bool)
 			fmt.Fprintf(w, "\033[33m!\033[0m %d potential dangling CNAMEs (possible subdomain takeover)\n", s.DanglingCNAMEs)
 		}
 		for _, dc := range result.DanglingCNAMEs {
-			fmt.Fprintf(w, "  %s -> %s (%s)\n", dc.Host, dc.CNAME, dc.Status)
+			verdict := "Suspected"
+			if dc.Confirmed {
+				verdict = "Confirmed"
+			}
+			if dc.Service != "" {
+				fmt.Fprintf(w, "  [%s] %s -> %s (%s/%s) [%s]: %s\n", verdict, dc.Host, dc.CNAME, dc.Status, dc.Severity, dc.Service, dc.Evidence)
+			} else {
+				fmt.Fprintf(w, "  [%s] %s -> %s (%s)\n", verdict, dc.Host, dc.CNAME, dc.Status)
+			}
+		}
+	}
+
+	if s.FindingCount > 0 {
+		fmt.Fprintln(w)
+		if noColor {
+			fmt.Fprintf(w, "! %d vulnerability findings\n", s.FindingCount)
+		} else {
+			fmt.Fprintf(w, "\033[33m!\033[0m %d vulnerability findings\n", s.FindingCount)
+		}
+		for _, f := range result.Findings {
+			if f.CVE != "" {
+				fmt.Fprintf(w, "  [%s] %s (%s): %s\n", f.Severity, f.Name, f.CVE, f.MatchedAt)
+			} else {
+				fmt.Fprintf(w, "  [%s] %s: %s\n", f.Severity, f.Name, f.MatchedAt)
+			}
 		}
 	}
 
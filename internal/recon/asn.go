@@ -0,0 +1,79 @@
+package recon
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// radbWhoisAddr is RADB's public IRR whois service. A "!g" query returns
+// every IPv4 route registered as originated by an AS, the same mechanism
+// tools like bgpq4 use for ASN-to-prefix expansion.
+const radbWhoisAddr = "whois.radb.net:43"
+
+const asnWhoisTimeout = 15 * time.Second
+
+// ASNPrefixes looks up the IPv4 prefixes originated by asn, accepting
+// either "13335" or "AS13335".
+func ASNPrefixes(ctx context.Context, asn string) ([]string, error) {
+	num := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(asn)), "AS")
+	if num == "" {
+		return nil, fmt.Errorf("empty ASN")
+	}
+
+	lines, err := queryWhois(ctx, radbWhoisAddr, fmt.Sprintf("!gas%s\n", num))
+	if err != nil {
+		return nil, fmt.Errorf("ASN %s: whois lookup: %w", asn, err)
+	}
+
+	prefixes := parseWhoisRoutes(lines)
+	if len(prefixes) == 0 {
+		return nil, fmt.Errorf("ASN %s: no routes found", asn)
+	}
+	return prefixes, nil
+}
+
+// parseWhoisRoutes extracts CIDR-shaped tokens from a RADB "!g" route-query
+// response, which is a whitespace-separated list of prefixes.
+func parseWhoisRoutes(lines []string) []string {
+	var prefixes []string
+	for _, line := range lines {
+		for _, field := range strings.Fields(line) {
+			if _, _, err := net.ParseCIDR(field); err == nil {
+				prefixes = append(prefixes, field)
+			}
+		}
+	}
+	return prefixes
+}
+
+// queryWhois sends a single query to a whois server and returns its
+// response split into lines.
+func queryWhois(ctx context.Context, addr, query string) ([]string, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, asnWhoisTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(dialCtx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := dialCtx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte(query)); err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,24 @@
+package recon
+
+import "testing"
+
+func TestParseWhoisRoutesExtractsCIDRs(t *testing.T) {
+	lines := []string{"104.16.0.0/13 104.24.0.0/14", "route:   172.64.0.0/13", "", "not-a-cidr"}
+
+	got := parseWhoisRoutes(lines)
+	want := []string{"104.16.0.0/13", "104.24.0.0/14", "172.64.0.0/13"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestParseWhoisRoutesEmpty(t *testing.T) {
+	if got := parseWhoisRoutes(nil); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
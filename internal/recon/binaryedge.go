@@ -0,0 +1,67 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	binaryEdgeBaseURL = "https://api.binaryedge.io/v2/query/domains/subdomain/%s"
+	binaryEdgeTimeout = 15 * time.Second
+	binaryEdgeMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type binaryEdgeResponse struct {
+	Events []string `json:"events"`
+}
+
+// BinaryEdgeEnumerate queries the BinaryEdge subdomain API.
+// Requires an API key; returns an error if apiKey is empty.
+func BinaryEdgeEnumerate(ctx context.Context, domain, apiKey string) ([]string, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("binaryedge: no API key configured")
+	}
+
+	url := fmt.Sprintf(binaryEdgeBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, map[string]string{"X-Key": apiKey}, binaryEdgeTimeout, binaryEdgeMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("binaryedge fetch for %s: %w", domain, err)
+	}
+
+	return parseBinaryEdgeResponse(body, domain)
+}
+
+// parseBinaryEdgeResponse extracts hostnames from a BinaryEdge subdomain
+// query response.
+func parseBinaryEdgeResponse(body []byte, domain string) ([]string, error) {
+	var resp binaryEdgeResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("binaryedge JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, h := range resp.Events {
+		host := strings.ToLower(strings.TrimSpace(h))
+		if host == "" {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// binaryEdgeSource adapts BinaryEdgeEnumerate to PassiveSource.
+type binaryEdgeSource struct{ APIKey string }
+
+func (s *binaryEdgeSource) Name() string  { return "binaryedge" }
+func (s *binaryEdgeSource) Enabled() bool { return s.APIKey != "" }
+func (s *binaryEdgeSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return BinaryEdgeEnumerate(ctx, domain, s.APIKey)
+}
@@ -0,0 +1,23 @@
+package recon
+
+import "testing"
+
+func TestBinaryEdgeEnumerateRequiresAPIKey(t *testing.T) {
+	if _, err := BinaryEdgeEnumerate(nil, "example.com", ""); err == nil {
+		t.Fatal("expected error with no API key")
+	}
+}
+
+func TestParseBinaryEdgeResponse(t *testing.T) {
+	body := []byte(`{"events":["www.example.com","api.example.com","www.example.com"]}`)
+
+	hosts, err := parseBinaryEdgeResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(expected) {
+		t.Fatalf("got %d hosts, want %d: %v", len(hosts), len(expected), hosts)
+	}
+}
@@ -3,64 +3,44 @@ package recon
 import (
 	"context"
 	"fmt"
-	"net"
 	"strings"
-	"sync"
 
+	"github.com/vulnverified/sweep/internal/engine"
 	"github.com/vulnverified/sweep/internal/wordlist"
 )
 
-// BruteEnumerate performs DNS brute-force subdomain enumeration using the embedded wordlist.
-// Returns hostnames that resolved successfully.
-func BruteEnumerate(ctx context.Context, domain string, concurrency int) ([]string, error) {
+// BruteEnumerate performs DNS brute-force subdomain enumeration using the
+// embedded wordlist. Returns hostnames that resolved successfully.
+//
+// resolver does the actual lookups, so brute-force shares the same
+// resolver pool (system, DoH, DoT, or round-robin upstreams) as the rest
+// of DNS resolution instead of going through net.DefaultResolver on its
+// own. A nil resolver falls back to the platform stub resolver.
+func BruteEnumerate(ctx context.Context, domain string, concurrency int, resolver engine.DNSResolver) ([]string, error) {
 	words := wordlist.Subdomains()
 	if len(words) == 0 {
 		return nil, fmt.Errorf("empty subdomain wordlist")
 	}
 
-	type workItem struct {
-		subdomain string
+	candidates := make([]string, len(words))
+	for i, w := range words {
+		candidates[i] = fmt.Sprintf("%s.%s", w, domain)
 	}
 
-	work := make(chan workItem, len(words))
-	for _, w := range words {
-		work <- workItem{subdomain: fmt.Sprintf("%s.%s", w, domain)}
+	if resolver == nil {
+		resolver = &Resolver{}
 	}
-	close(work)
 
-	var (
-		mu    sync.Mutex
-		found []string
-	)
-
-	var wg sync.WaitGroup
-	for i := 0; i < concurrency; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for item := range work {
-				select {
-				case <-ctx.Done():
-					return
-				default:
-				}
-
-				ips, err := net.DefaultResolver.LookupHost(ctx, item.subdomain)
-				if err != nil {
-					continue
-				}
-				if len(ips) == 0 {
-					continue
-				}
-
-				host := strings.ToLower(item.subdomain)
-				mu.Lock()
-				found = append(found, host)
-				mu.Unlock()
-			}
-		}()
+	records, _, err := resolver.Resolve(ctx, domain, candidates, concurrency)
+	if err != nil {
+		return nil, err
 	}
 
-	wg.Wait()
+	found := make([]string, 0, len(records))
+	for _, r := range records {
+		if len(r.IPs) > 0 {
+			found = append(found, strings.ToLower(r.Host))
+		}
+	}
 	return found, nil
 }
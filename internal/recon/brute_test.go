@@ -0,0 +1,43 @@
+package recon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vulnverified/sweep/internal/engine"
+	"github.com/vulnverified/sweep/internal/wordlist"
+)
+
+// fakeBruteResolver implements engine.DNSResolver, resolving only the
+// given set of hosts so tests can check BruteEnumerate filters correctly.
+type fakeBruteResolver struct {
+	live map[string]bool
+}
+
+func (f *fakeBruteResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	var records []engine.DNSResult
+	for _, h := range hosts {
+		if f.live[h] {
+			records = append(records, engine.DNSResult{Host: h, IPs: []string{"1.2.3.4"}})
+		}
+	}
+	return records, nil, nil
+}
+
+func TestBruteEnumerate_UsesProvidedResolver(t *testing.T) {
+	words := wordlist.Subdomains()
+	if len(words) == 0 {
+		t.Skip("embedded wordlist is empty")
+	}
+
+	live := words[0] + ".example.com"
+	resolver := &fakeBruteResolver{live: map[string]bool{live: true}}
+
+	found, err := BruteEnumerate(context.Background(), "example.com", 4, resolver)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(found) != 1 || found[0] != live {
+		t.Errorf("found = %v, want [%q]", found, live)
+	}
+}
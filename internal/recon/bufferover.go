@@ -0,0 +1,71 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	bufferoverBaseURL = "https://dns.bufferover.run/dns?q=.%s"
+	bufferoverTimeout = 15 * time.Second
+	bufferoverMaxBody = 20 * 1024 * 1024 // 20MB
+)
+
+type bufferoverResponse struct {
+	FDNSA []string `json:"FDNS_A"`
+	RDNS  []string `json:"RDNS"`
+}
+
+// BufferOverEnumerate queries the BufferOver passive DNS API for
+// subdomains. No API key required. Entries are "ip,hostname" pairs.
+func BufferOverEnumerate(ctx context.Context, domain string) ([]string, error) {
+	url := fmt.Sprintf(bufferoverBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, nil, bufferoverTimeout, bufferoverMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("bufferover fetch for %s: %w", domain, err)
+	}
+
+	return parseBufferOverResponse(body, domain)
+}
+
+// parseBufferOverResponse parses BufferOver's {"FDNS_A": [...], "RDNS": [...]}
+// payload of "ip,hostname" pairs into deduplicated, in-scope hostnames.
+func parseBufferOverResponse(body []byte, domain string) ([]string, error) {
+	var resp bufferoverResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("bufferover JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, entry := range append(resp.FDNSA, resp.RDNS...) {
+		parts := strings.SplitN(entry, ",", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		host := strings.ToLower(strings.TrimSpace(parts[1]))
+		if host == "" {
+			continue
+		}
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// bufferoverSource adapts BufferOverEnumerate to PassiveSource.
+type bufferoverSource struct{}
+
+func (s *bufferoverSource) Name() string  { return "bufferover" }
+func (s *bufferoverSource) Enabled() bool { return true }
+func (s *bufferoverSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return BufferOverEnumerate(ctx, domain)
+}
@@ -0,0 +1,28 @@
+package recon
+
+import "testing"
+
+func TestParseBufferOverResponse(t *testing.T) {
+	body := []byte(`{"FDNS_A":["1.2.3.4,www.example.com","5.6.7.8,api.example.com"],"RDNS":["9.9.9.9,other.notexample.com"]}`)
+
+	hosts, err := parseBufferOverResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(expected) {
+		t.Fatalf("got %d hosts, want %d: %v", len(hosts), len(expected), hosts)
+	}
+	for _, h := range hosts {
+		if !expected[h] {
+			t.Errorf("unexpected host: %s", h)
+		}
+	}
+}
+
+func TestParseBufferOverResponseMalformedJSON(t *testing.T) {
+	if _, err := parseBufferOverResponse([]byte("not json"), "example.com"); err == nil {
+		t.Fatal("expected error on malformed JSON")
+	}
+}
@@ -0,0 +1,81 @@
+package recon
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	censysBaseURL = "https://search.censys.io/api/v2/hosts/search?q=dns.names%%3A+%s&per_page=100"
+	censysTimeout = 15 * time.Second
+	censysMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type censysResponse struct {
+	Result struct {
+		Hits []struct {
+			DNS struct {
+				Names []string `json:"names"`
+			} `json:"dns"`
+		} `json:"hits"`
+	} `json:"result"`
+}
+
+// CensysEnumerate queries the Censys Search v2 hosts API for hostnames
+// observed for the target domain. Requires an (apiID, apiSecret) key
+// pair, HTTP Basic-authenticated; returns an error if either is empty.
+func CensysEnumerate(ctx context.Context, domain, apiID, apiSecret string) ([]string, error) {
+	if apiID == "" || apiSecret == "" {
+		return nil, fmt.Errorf("censys: no API credentials configured")
+	}
+
+	url := fmt.Sprintf(censysBaseURL, domain)
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(apiID+":"+apiSecret))
+	body, err := sourceHTTPGet(ctx, url, map[string]string{"Authorization": auth}, censysTimeout, censysMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("censys fetch for %s: %w", domain, err)
+	}
+
+	return parseCensysResponse(body, domain)
+}
+
+// parseCensysResponse extracts in-scope hostnames from a Censys hosts
+// search response.
+func parseCensysResponse(body []byte, domain string) ([]string, error) {
+	var resp censysResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("censys JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, hit := range resp.Result.Hits {
+		for _, name := range hit.DNS.Names {
+			host := strings.ToLower(strings.TrimSpace(name))
+			if host == "" {
+				continue
+			}
+			if !strings.HasSuffix(host, "."+domain) && host != domain {
+				continue
+			}
+			if !seen[host] {
+				seen[host] = true
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	return hosts, nil
+}
+
+// censysSource adapts CensysEnumerate to PassiveSource.
+type censysSource struct{ APIID, APISecret string }
+
+func (s *censysSource) Name() string  { return "censys" }
+func (s *censysSource) Enabled() bool { return s.APIID != "" && s.APISecret != "" }
+func (s *censysSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return CensysEnumerate(ctx, domain, s.APIID, s.APISecret)
+}
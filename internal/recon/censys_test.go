@@ -0,0 +1,24 @@
+package recon
+
+import "testing"
+
+func TestCensysEnumerateRequiresCredentials(t *testing.T) {
+	if _, err := CensysEnumerate(nil, "example.com", "", "secret"); err == nil {
+		t.Fatal("expected error with no API ID")
+	}
+	if _, err := CensysEnumerate(nil, "example.com", "id", ""); err == nil {
+		t.Fatal("expected error with no API secret")
+	}
+}
+
+func TestParseCensysResponse(t *testing.T) {
+	body := []byte(`{"result":{"hits":[{"dns":{"names":["www.example.com","other.notexample.com"]}}]}}`)
+
+	hosts, err := parseCensysResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
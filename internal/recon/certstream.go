@@ -0,0 +1,153 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	certStreamURL = "wss://certstream.calidog.io/"
+
+	certStreamInitialBackoff = 1 * time.Second
+	certStreamMaxBackoff     = 30 * time.Second
+)
+
+// certStreamMessage is the subset of CertStream's full-stream message
+// format this source cares about.
+type certStreamMessage struct {
+	Data struct {
+		LeafCert struct {
+			AllDomains []string `json:"all_domains"`
+		} `json:"leaf_cert"`
+	} `json:"data"`
+}
+
+// CertStreamEnumerate connects to a Certificate Transparency websocket
+// firehose (CertStream's full-stream feed by default) and collects
+// hostnames under domain seen in freshly issued leaf certificates for up
+// to duration. Unlike crt.sh's cached JSON index, this catches
+// certificates within seconds of issuance, at the cost of only seeing
+// whatever gets issued during the listening window. Reconnects use
+// exponential backoff (capped at certStreamMaxBackoff) so a firehose
+// restart or network blip doesn't abort the whole listening window.
+// Hosts found here are deduplicated against crt.sh's results the same way
+// every other source is: by the shared hostname map Enumerate builds up
+// across all passive sources, not by any CertStream-specific state.
+func CertStreamEnumerate(ctx context.Context, domain string, duration time.Duration) ([]string, error) {
+	return certStreamEnumerate(ctx, certStreamURL, domain, duration)
+}
+
+func certStreamEnumerate(ctx context.Context, url, domain string, duration time.Duration) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	seen := make(map[string]bool)
+	var hosts []string
+	backoff := certStreamInitialBackoff
+
+	for ctx.Err() == nil {
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+		if err != nil {
+			if !sleepWithContext(ctx, backoff) {
+				break
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+		backoff = certStreamInitialBackoff
+
+		readCertStreamFeed(ctx, conn, domain, seen, &hosts)
+		conn.Close()
+	}
+
+	return hosts, nil
+}
+
+// readCertStreamFeed reads messages from conn until it errors (connection
+// drop) or ctx is done (closing conn from another goroutine to unblock the
+// read), accumulating matching hosts into hosts.
+func readCertStreamFeed(ctx context.Context, conn *websocket.Conn, domain string, seen map[string]bool, hosts *[]string) {
+	closed := make(chan struct{})
+	defer close(closed)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-closed:
+		}
+	}()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		matched, err := parseCertStreamMessage(raw, domain)
+		if err != nil {
+			continue
+		}
+		for _, host := range matched {
+			if !seen[host] {
+				seen[host] = true
+				*hosts = append(*hosts, host)
+			}
+		}
+	}
+}
+
+// parseCertStreamMessage extracts in-scope hostnames from a single
+// CertStream feed message, stripping the leading "*." off wildcard SAN
+// entries.
+func parseCertStreamMessage(raw []byte, domain string) ([]string, error) {
+	var msg certStreamMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, d := range msg.Data.LeafCert.AllDomains {
+		host := strings.ToLower(strings.TrimPrefix(d, "*."))
+		if host == "" {
+			continue
+		}
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func nextBackoff(d time.Duration) time.Duration {
+	d *= 2
+	if d > certStreamMaxBackoff {
+		return certStreamMaxBackoff
+	}
+	return d
+}
+
+// certStreamSource adapts CertStreamEnumerate to PassiveSource. Unlike the
+// other sources, it isn't query-and-return: it blocks for Duration, so it
+// self-disables (Enabled() == false) unless a duration was explicitly
+// configured.
+type certStreamSource struct{ Duration time.Duration }
+
+func (s *certStreamSource) Name() string  { return "certstream" }
+func (s *certStreamSource) Enabled() bool { return s.Duration > 0 }
+func (s *certStreamSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return CertStreamEnumerate(ctx, domain, s.Duration)
+}
@@ -0,0 +1,48 @@
+package recon
+
+import "testing"
+
+func TestParseCertStreamMessageFiltersToScopeAndStripsWildcards(t *testing.T) {
+	raw := []byte(`{"data":{"leaf_cert":{"all_domains":["*.www.example.com","api.example.com","other.notexample.com"]}}}`)
+
+	hosts, err := parseCertStreamMessage(raw, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(want) {
+		t.Fatalf("got %v, want %v", hosts, want)
+	}
+	for _, h := range hosts {
+		if !want[h] {
+			t.Errorf("unexpected host %s in %v", h, hosts)
+		}
+	}
+}
+
+func TestParseCertStreamMessageInvalidJSON(t *testing.T) {
+	if _, err := parseCertStreamMessage([]byte("not json"), "example.com"); err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+}
+
+func TestNextBackoffCapsAtMax(t *testing.T) {
+	d := certStreamInitialBackoff
+	for i := 0; i < 10; i++ {
+		d = nextBackoff(d)
+	}
+	if d != certStreamMaxBackoff {
+		t.Errorf("got %v, want %v", d, certStreamMaxBackoff)
+	}
+}
+
+func TestCertStreamSourceEnabledGating(t *testing.T) {
+	disabled := &certStreamSource{}
+	if disabled.Enabled() {
+		t.Error("expected certstream to be disabled with a zero duration")
+	}
+	enabled := &certStreamSource{Duration: 30}
+	if !enabled.Enabled() {
+		t.Error("expected certstream to be enabled with a non-zero duration")
+	}
+}
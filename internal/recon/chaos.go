@@ -0,0 +1,74 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	chaosBaseURL = "https://dns.projectdiscovery.io/dns/%s/subdomains"
+	chaosTimeout = 15 * time.Second
+	chaosMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type chaosResponse struct {
+	Domain     string   `json:"domain"`
+	Subdomains []string `json:"subdomains"`
+}
+
+// ChaosEnumerate queries ProjectDiscovery's Chaos dataset for subdomains.
+// Requires an API key; returns an error if apiKey is empty so callers can
+// distinguish "not configured" from "query failed".
+func ChaosEnumerate(ctx context.Context, domain, apiKey string) ([]string, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("chaos: no API key configured")
+	}
+
+	url := fmt.Sprintf(chaosBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, map[string]string{"Authorization": apiKey}, chaosTimeout, chaosMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("chaos fetch for %s: %w", domain, err)
+	}
+
+	return parseChaosResponse(body, domain)
+}
+
+// parseChaosResponse extracts hostnames from a Chaos subdomains response.
+// Chaos sometimes returns bare labels (e.g. "www") and sometimes full
+// hostnames depending on the dataset, so a label with no dot is qualified
+// with the target domain.
+func parseChaosResponse(body []byte, domain string) ([]string, error) {
+	var resp chaosResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("chaos JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, label := range resp.Subdomains {
+		host := strings.ToLower(strings.TrimSpace(label))
+		if host == "" {
+			continue
+		}
+		if !strings.Contains(host, ".") {
+			host = host + "." + domain
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// chaosSource adapts ChaosEnumerate to PassiveSource.
+type chaosSource struct{ APIKey string }
+
+func (s *chaosSource) Name() string  { return "chaos" }
+func (s *chaosSource) Enabled() bool { return s.APIKey != "" }
+func (s *chaosSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return ChaosEnumerate(ctx, domain, s.APIKey)
+}
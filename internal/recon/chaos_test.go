@@ -0,0 +1,28 @@
+package recon
+
+import "testing"
+
+func TestChaosEnumerateRequiresAPIKey(t *testing.T) {
+	if _, err := ChaosEnumerate(nil, "example.com", ""); err == nil {
+		t.Fatal("expected error with no API key")
+	}
+}
+
+func TestParseChaosResponseQualifiesBareLabels(t *testing.T) {
+	body := []byte(`{"domain":"example.com","subdomains":["www","api.example.com"]}`)
+
+	hosts, err := parseChaosResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(expected) {
+		t.Fatalf("got %d hosts, want %d: %v", len(hosts), len(expected), hosts)
+	}
+	for _, h := range hosts {
+		if !expected[h] {
+			t.Errorf("unexpected host: %s", h)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	commonCrawlCollInfoURL = "https://index.commoncrawl.org/collinfo.json"
+	commonCrawlIndexURLFmt = "%s?url=*.%s/*&output=json&fl=url"
+	commonCrawlTimeout     = 30 * time.Second
+	commonCrawlMaxBody     = 50 * 1024 * 1024 // 50MB
+)
+
+type commonCrawlCollection struct {
+	ID     string `json:"id"`
+	CDXAPI string `json:"cdx-api"`
+}
+
+// CommonCrawlEnumerate queries the most recent CommonCrawl index for URLs
+// under the target domain and extracts their hostnames. No API key
+// required. Unlike the CDX API's JSON-array format, CommonCrawl's index
+// returns one JSON object per line (not a JSON array), so each line is
+// decoded independently and a bad line is skipped rather than failing the
+// whole query.
+func CommonCrawlEnumerate(ctx context.Context, domain string) ([]string, error) {
+	collections, err := commonCrawlLatestCollection(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("commoncrawl collection lookup: %w", err)
+	}
+	if len(collections) == 0 {
+		return nil, fmt.Errorf("commoncrawl: no collections available")
+	}
+
+	indexURL := fmt.Sprintf(commonCrawlIndexURLFmt, collections[0].CDXAPI, domain)
+	body, err := sourceHTTPGet(ctx, indexURL, nil, commonCrawlTimeout, commonCrawlMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("commoncrawl fetch for %s: %w", domain, err)
+	}
+
+	return parseCommonCrawlIndex(body, domain), nil
+}
+
+// parseCommonCrawlIndex extracts in-scope hostnames from CommonCrawl's
+// newline-delimited-JSON index response, skipping lines that don't parse.
+func parseCommonCrawlIndex(body []byte, domain string) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var row struct {
+			URL string `json:"url"`
+		}
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			continue
+		}
+		u, err := url.Parse(row.URL)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		host := strings.ToLower(u.Hostname())
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// commonCrawlLatestCollection fetches the list of available CommonCrawl
+// indexes, newest first.
+func commonCrawlLatestCollection(ctx context.Context) ([]commonCrawlCollection, error) {
+	body, err := sourceHTTPGet(ctx, commonCrawlCollInfoURL, nil, commonCrawlTimeout, commonCrawlMaxBody)
+	if err != nil {
+		return nil, err
+	}
+	var collections []commonCrawlCollection
+	if err := json.Unmarshal(body, &collections); err != nil {
+		return nil, fmt.Errorf("collinfo JSON parse: %w", err)
+	}
+	return collections, nil
+}
+
+// commonCrawlSource adapts CommonCrawlEnumerate to PassiveSource.
+type commonCrawlSource struct{}
+
+func (s *commonCrawlSource) Name() string  { return "commoncrawl" }
+func (s *commonCrawlSource) Enabled() bool { return true }
+func (s *commonCrawlSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return CommonCrawlEnumerate(ctx, domain)
+}
@@ -0,0 +1,12 @@
+package recon
+
+import "testing"
+
+func TestParseCommonCrawlIndexSkipsBadLines(t *testing.T) {
+	body := []byte("{\"url\":\"https://www.example.com/a\"}\nnot json\n{\"url\":\"https://other.notexample.com/\"}\n")
+
+	hosts := parseCommonCrawlIndex(body, "example.com")
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net"
 	"strings"
-	"sync"
 
 	"github.com/vulnverified/sweep/internal/engine"
 )
@@ -12,67 +11,44 @@ import (
 // DNSResolve performs A/AAAA and CNAME resolution for the given hosts.
 // Returns resolved records and detected dangling CNAMEs.
 func DNSResolve(ctx context.Context, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
-	work := make(chan string, len(hosts))
-	for _, h := range hosts {
-		work <- h
-	}
-	close(work)
-
-	var (
-		mu        sync.Mutex
-		results   []engine.DNSResult
-		danglings []engine.DanglingCNAME
-	)
-
-	var wg sync.WaitGroup
-	for i := 0; i < concurrency; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for host := range work {
-				select {
-				case <-ctx.Done():
-					return
-				default:
-				}
-
-				record := engine.DNSResult{Host: host}
-
-				// Check CNAME first.
-				cname, err := net.DefaultResolver.LookupCNAME(ctx, host)
-				if err == nil {
-					cname = strings.TrimSuffix(strings.ToLower(cname), ".")
-					if cname != host && cname != "" {
-						record.CNAME = cname
-					}
-				}
+	return resolveHostsConcurrently(ctx, hosts, concurrency, plainResolveHost)
+}
 
-				// Resolve A/AAAA records.
-				ips, err := net.DefaultResolver.LookupHost(ctx, host)
-				if err != nil {
-					// Host didn't resolve — check if it's a dangling CNAME.
-					if record.CNAME != "" {
-						dangling := checkDangling(host, record.CNAME, err)
-						if dangling != nil {
-							mu.Lock()
-							danglings = append(danglings, *dangling)
-							mu.Unlock()
-						}
-					}
-					continue
-				}
+// plainResolveHost resolves a single host via the platform stub resolver.
+func plainResolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	record := engine.DNSResult{Host: host}
 
-				record.IPs = deduplicateStrings(ips)
+	// Check CNAME first.
+	cname, err := net.DefaultResolver.LookupCNAME(ctx, host)
+	if err == nil {
+		cname = strings.TrimSuffix(strings.ToLower(cname), ".")
+		if cname != host && cname != "" {
+			record.CNAME = cname
+		}
+	}
 
-				mu.Lock()
-				results = append(results, record)
-				mu.Unlock()
+	// Resolve A/AAAA records.
+	ips, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		// Host didn't resolve — check if it's a dangling CNAME.
+		if record.CNAME != "" {
+			if dangling := checkDangling(host, record.CNAME, err); dangling != nil {
+				return engine.DNSResult{}, dangling, false
 			}
-		}()
+		}
+		return engine.DNSResult{}, nil, false
 	}
 
-	wg.Wait()
-	return results, danglings, nil
+	record.IPs = deduplicateStrings(ips)
+
+	extra := queryExtraRecords(ctx, host)
+	record.MX = extra.mx
+	record.NS = extra.ns
+	record.TXT = extra.txt
+	record.CAA = extra.caa
+	record.SRV = extra.srv
+
+	return record, nil, true
 }
 
 // LiveHostsFromDNS extracts unique hostnames that resolved successfully.
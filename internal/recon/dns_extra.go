@@ -0,0 +1,155 @@
+package recon
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const extraRecordTimeout = 5 * time.Second
+
+// commonSRVServices are the service names probed when looking for SRV
+// records, since SRV lookups require a specific service/proto prefix
+// rather than resolving directly against the bare host.
+var commonSRVServices = []string{"_autodiscover._tcp", "_sip._tls", "_sipfederationtls._tcp", "_xmpp-client._tcp"}
+
+// extraRecords holds the MX/NS/TXT/CAA/SRV records fetched for a host.
+type extraRecords struct {
+	mx  []engine.MXRecord
+	ns  []string
+	txt []string
+	caa []engine.CAARecord
+	srv []engine.SRVRecord
+}
+
+// queryExtraRecords fetches MX, NS, TXT, CAA, and SRV records for host in
+// parallel using github.com/miekg/dns against the system's configured
+// resolvers, falling back to net.Resolver for MX/NS/TXT if the system
+// resolver config can't be read (e.g. non-Unix platforms). CAA and SRV
+// have no net.Resolver equivalent, so they're left empty on fallback.
+func queryExtraRecords(ctx context.Context, host string) extraRecords {
+	cfg, err := dns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil || len(cfg.Servers) == 0 {
+		return fallbackExtraRecords(ctx, host)
+	}
+	addr := net.JoinHostPort(cfg.Servers[0], cfg.Port)
+	client := &dns.Client{Timeout: extraRecordTimeout}
+
+	var out extraRecords
+	var wg sync.WaitGroup
+	wg.Add(5)
+
+	go func() {
+		defer wg.Done()
+		out.mx = queryMX(ctx, client, addr, host)
+	}()
+	go func() {
+		defer wg.Done()
+		out.ns = queryNS(ctx, client, addr, host)
+	}()
+	go func() {
+		defer wg.Done()
+		out.txt = queryTXT(ctx, client, addr, host)
+	}()
+	go func() {
+		defer wg.Done()
+		out.caa = queryCAA(ctx, client, addr, host)
+	}()
+	go func() {
+		defer wg.Done()
+		out.srv = querySRV(ctx, client, addr, host)
+	}()
+
+	wg.Wait()
+	return out
+}
+
+func fallbackExtraRecords(ctx context.Context, host string) extraRecords {
+	var out extraRecords
+	if records, err := net.DefaultResolver.LookupMX(ctx, host); err == nil {
+		for _, r := range records {
+			out.mx = append(out.mx, engine.MXRecord{Host: strings.TrimSuffix(r.Host, "."), Priority: int(r.Pref)})
+		}
+	}
+	if records, err := net.DefaultResolver.LookupNS(ctx, host); err == nil {
+		for _, r := range records {
+			out.ns = append(out.ns, strings.TrimSuffix(r.Host, "."))
+		}
+	}
+	if records, err := net.DefaultResolver.LookupTXT(ctx, host); err == nil {
+		out.txt = records
+	}
+	return out
+}
+
+func rawQuery(ctx context.Context, client *dns.Client, addr, name string, qtype uint16) []dns.RR {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), qtype)
+	reply, _, err := client.ExchangeContext(ctx, msg, addr)
+	if err != nil || reply == nil {
+		return nil
+	}
+	return reply.Answer
+}
+
+func queryMX(ctx context.Context, client *dns.Client, addr, host string) []engine.MXRecord {
+	var out []engine.MXRecord
+	for _, rr := range rawQuery(ctx, client, addr, host, dns.TypeMX) {
+		if mx, ok := rr.(*dns.MX); ok {
+			out = append(out, engine.MXRecord{Host: strings.TrimSuffix(mx.Mx, "."), Priority: int(mx.Preference)})
+		}
+	}
+	return out
+}
+
+func queryNS(ctx context.Context, client *dns.Client, addr, host string) []string {
+	var out []string
+	for _, rr := range rawQuery(ctx, client, addr, host, dns.TypeNS) {
+		if ns, ok := rr.(*dns.NS); ok {
+			out = append(out, strings.TrimSuffix(ns.Ns, "."))
+		}
+	}
+	return out
+}
+
+func queryTXT(ctx context.Context, client *dns.Client, addr, host string) []string {
+	var out []string
+	for _, rr := range rawQuery(ctx, client, addr, host, dns.TypeTXT) {
+		if txt, ok := rr.(*dns.TXT); ok {
+			out = append(out, strings.Join(txt.Txt, ""))
+		}
+	}
+	return out
+}
+
+func queryCAA(ctx context.Context, client *dns.Client, addr, host string) []engine.CAARecord {
+	var out []engine.CAARecord
+	for _, rr := range rawQuery(ctx, client, addr, host, dns.TypeCAA) {
+		if c, ok := rr.(*dns.CAA); ok {
+			out = append(out, engine.CAARecord{Tag: c.Tag, Value: c.Value})
+		}
+	}
+	return out
+}
+
+func querySRV(ctx context.Context, client *dns.Client, addr, host string) []engine.SRVRecord {
+	var out []engine.SRVRecord
+	for _, svc := range commonSRVServices {
+		for _, rr := range rawQuery(ctx, client, addr, svc+"."+host, dns.TypeSRV) {
+			if s, ok := rr.(*dns.SRV); ok {
+				out = append(out, engine.SRVRecord{
+					Target:   strings.TrimSuffix(s.Target, "."),
+					Port:     int(s.Port),
+					Priority: int(s.Priority),
+					Weight:   int(s.Weight),
+				})
+			}
+		}
+	}
+	return out
+}
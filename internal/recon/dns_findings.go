@@ -0,0 +1,135 @@
+package recon
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// commonDKIMSelectors are probed when checking for DKIM configuration;
+// a TXT record resolving for any one of them is enough to conclude DKIM
+// is set up, since most providers don't advertise their selector.
+var commonDKIMSelectors = []string{"google", "selector1", "selector2", "k1", "mandrill", "everlytickey1", "dkim"}
+
+// parkingMXHosts are mail-exchange hosts operated by domain parking
+// services; an MX record pointing at one usually means the domain is
+// unused rather than actively receiving mail.
+var parkingMXHosts = []string{"parkingcrew.net", "sedoparking.com", "above.com"}
+
+// AnalyzeDNSFindings inspects the resolved DNS records for domain and
+// produces lightweight security findings: missing/weak SPF, missing or
+// permissive DMARC, absent DKIM selectors, missing CAA, a wildcard MX
+// pointing at a parking service, and nameservers that don't resolve.
+func AnalyzeDNSFindings(ctx context.Context, domain string, records []engine.DNSResult) []engine.DNSFinding {
+	var root *engine.DNSResult
+	for i := range records {
+		if records[i].Host == domain {
+			root = &records[i]
+			break
+		}
+	}
+	if root == nil {
+		return nil
+	}
+
+	var findings []engine.DNSFinding
+	findings = append(findings, checkSPF(domain, root.TXT)...)
+	findings = append(findings, checkDMARC(ctx, domain)...)
+	findings = append(findings, checkDKIM(ctx, domain)...)
+	findings = append(findings, checkCAA(domain, root.CAA)...)
+	findings = append(findings, checkWildcardMX(domain, root.MX)...)
+	findings = append(findings, checkNSReachability(domain, root.NS)...)
+	return findings
+}
+
+func checkSPF(domain string, txt []string) []engine.DNSFinding {
+	for _, rec := range txt {
+		lower := strings.ToLower(rec)
+		if !strings.HasPrefix(lower, "v=spf1") {
+			continue
+		}
+		if strings.Contains(lower, "?all") || strings.Contains(lower, "+all") {
+			return []engine.DNSFinding{{
+				Host: domain, Category: "spf", Severity: "medium",
+				Detail: "SPF record uses a weak qualifier (?all or +all) that allows any host to send mail as this domain",
+			}}
+		}
+		return nil
+	}
+	return []engine.DNSFinding{{
+		Host: domain, Category: "spf", Severity: "medium",
+		Detail: "no SPF record found",
+	}}
+}
+
+func checkDMARC(ctx context.Context, domain string) []engine.DNSFinding {
+	txt, err := net.DefaultResolver.LookupTXT(ctx, "_dmarc."+domain)
+	if err != nil || len(txt) == 0 {
+		return []engine.DNSFinding{{
+			Host: domain, Category: "dmarc", Severity: "high",
+			Detail: "no DMARC record found at _dmarc." + domain,
+		}}
+	}
+	for _, rec := range txt {
+		lower := strings.ToLower(rec)
+		if strings.HasPrefix(lower, "v=dmarc1") && strings.Contains(lower, "p=none") {
+			return []engine.DNSFinding{{
+				Host: domain, Category: "dmarc", Severity: "medium",
+				Detail: "DMARC policy is p=none (monitor-only, not enforced)",
+			}}
+		}
+	}
+	return nil
+}
+
+func checkDKIM(ctx context.Context, domain string) []engine.DNSFinding {
+	for _, selector := range commonDKIMSelectors {
+		if txt, err := net.DefaultResolver.LookupTXT(ctx, selector+"._domainkey."+domain); err == nil && len(txt) > 0 {
+			return nil
+		}
+	}
+	return []engine.DNSFinding{{
+		Host: domain, Category: "dkim", Severity: "low",
+		Detail: "no DKIM record found for common selectors (google, selector1, selector2, k1, mandrill, everlytickey1, dkim)",
+	}}
+}
+
+func checkCAA(domain string, caa []engine.CAARecord) []engine.DNSFinding {
+	if len(caa) > 0 {
+		return nil
+	}
+	return []engine.DNSFinding{{
+		Host: domain, Category: "caa", Severity: "low",
+		Detail: "no CAA record found; any certificate authority can issue certificates for this domain",
+	}}
+}
+
+func checkWildcardMX(domain string, mx []engine.MXRecord) []engine.DNSFinding {
+	for _, rec := range mx {
+		host := strings.ToLower(rec.Host)
+		for _, parking := range parkingMXHosts {
+			if strings.Contains(host, parking) {
+				return []engine.DNSFinding{{
+					Host: domain, Category: "wildcard-mx", Severity: "info",
+					Detail: "MX record points at a domain parking service (" + rec.Host + ")",
+				}}
+			}
+		}
+	}
+	return nil
+}
+
+func checkNSReachability(domain string, ns []string) []engine.DNSFinding {
+	var findings []engine.DNSFinding
+	for _, nameserver := range ns {
+		if _, err := net.LookupHost(nameserver); err != nil {
+			findings = append(findings, engine.DNSFinding{
+				Host: domain, Category: "ns-unreachable", Severity: "medium",
+				Detail: "nameserver " + nameserver + " does not resolve",
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,64 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func TestCheckSPF(t *testing.T) {
+	tests := []struct {
+		name     string
+		txt      []string
+		wantNone bool
+	}{
+		{name: "no TXT records", txt: nil},
+		{name: "no SPF record", txt: []string{"some-other-verification=abc123"}},
+		{name: "weak ?all qualifier", txt: []string{"v=spf1 include:_spf.example.com ?all"}},
+		{name: "weak +all qualifier", txt: []string{"v=spf1 +all"}},
+		{name: "strict -all qualifier", txt: []string{"v=spf1 include:_spf.example.com -all"}, wantNone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := checkSPF("example.com", tt.txt)
+			if tt.wantNone {
+				if len(findings) != 0 {
+					t.Errorf("expected no findings, got %+v", findings)
+				}
+				return
+			}
+			if len(findings) != 1 || findings[0].Category != "spf" {
+				t.Errorf("expected one spf finding, got %+v", findings)
+			}
+		})
+	}
+}
+
+func TestCheckCAA(t *testing.T) {
+	if findings := checkCAA("example.com", nil); len(findings) != 1 || findings[0].Category != "caa" {
+		t.Errorf("expected one caa finding when absent, got %+v", findings)
+	}
+	if findings := checkCAA("example.com", []engine.CAARecord{{Tag: "issue", Value: "letsencrypt.org"}}); len(findings) != 0 {
+		t.Errorf("expected no findings when CAA present, got %+v", findings)
+	}
+}
+
+func TestCheckWildcardMX(t *testing.T) {
+	parked := []engine.MXRecord{{Host: "park.sedoparking.com", Priority: 10}}
+	if findings := checkWildcardMX("example.com", parked); len(findings) != 1 || findings[0].Category != "wildcard-mx" {
+		t.Errorf("expected one wildcard-mx finding, got %+v", findings)
+	}
+
+	legit := []engine.MXRecord{{Host: "aspmx.l.google.com", Priority: 1}}
+	if findings := checkWildcardMX("example.com", legit); len(findings) != 0 {
+		t.Errorf("expected no findings for legitimate MX, got %+v", findings)
+	}
+}
+
+func TestAnalyzeDNSFindings_NoMatchingHostReturnsNil(t *testing.T) {
+	records := []engine.DNSResult{{Host: "www.example.com", IPs: []string{"1.2.3.4"}}}
+	if findings := AnalyzeDNSFindings(nil, "example.com", records); findings != nil {
+		t.Errorf("expected nil when domain isn't among resolved records, got %+v", findings)
+	}
+}
@@ -0,0 +1,125 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	dnsdumpsterHomeURL = "https://dnsdumpster.com/"
+	dnsdumpsterAPIURL  = "https://dnsdumpster.com/"
+	dnsdumpsterTimeout = 30 * time.Second
+	dnsdumpsterMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+var (
+	dnsdumpsterCSRFRegex = regexp.MustCompile(`name=["']csrfmiddlewaretoken["']\s+value=["']([^"']+)["']`)
+	dnsdumpsterHostRegex = regexp.MustCompile(`(?i)([a-z0-9_-]+(?:\.[a-z0-9_-]+)+)\.?</td>`)
+)
+
+// DNSDumpsterEnumerate scrapes dnsdumpster.com: it first loads the home
+// page to pick up a CSRF token and session cookie, then POSTs the domain
+// and regex-scrapes the resulting HTML table. No API key required, but
+// dnsdumpster rate-limits aggressively, so this is best-effort.
+func DNSDumpsterEnumerate(ctx context.Context, domain, userAgent string) ([]string, error) {
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{Jar: jar}
+
+	csrfToken, err := dnsdumpsterCSRFToken(ctx, client, userAgent)
+	if err != nil {
+		return nil, fmt.Errorf("dnsdumpster csrf token: %w", err)
+	}
+
+	form := url.Values{"csrfmiddlewaretoken": {csrfToken}, "targetip": {domain}, "user": {"free"}}
+	reqCtx, cancel := context.WithTimeout(ctx, dnsdumpsterTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, dnsdumpsterAPIURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", dnsdumpsterHomeURL)
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("dnsdumpster fetch for %s: %w", domain, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("dnsdumpster returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dnsdumpsterMaxBody))
+	if err != nil {
+		return nil, fmt.Errorf("dnsdumpster read body: %w", err)
+	}
+
+	return parseDNSDumpsterTable(body, domain), nil
+}
+
+// parseDNSDumpsterTable extracts in-scope hostnames from dnsdumpster's HTML
+// results table.
+func parseDNSDumpsterTable(body []byte, domain string) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, match := range dnsdumpsterHostRegex.FindAllStringSubmatch(string(body), -1) {
+		host := strings.ToLower(match[1])
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+func dnsdumpsterCSRFToken(ctx context.Context, client *http.Client, userAgent string) (string, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, dnsdumpsterTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, dnsdumpsterHomeURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dnsdumpsterMaxBody))
+	if err != nil {
+		return "", err
+	}
+
+	match := dnsdumpsterCSRFRegex.FindStringSubmatch(string(body))
+	if match == nil {
+		return "", fmt.Errorf("csrf token not found in response")
+	}
+	return match[1], nil
+}
+
+// dnsdumpsterSource adapts DNSDumpsterEnumerate to PassiveSource.
+type dnsdumpsterSource struct{ UserAgent string }
+
+func (s *dnsdumpsterSource) Name() string  { return "dnsdumpster" }
+func (s *dnsdumpsterSource) Enabled() bool { return true }
+func (s *dnsdumpsterSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return DNSDumpsterEnumerate(ctx, domain, s.UserAgent)
+}
@@ -0,0 +1,12 @@
+package recon
+
+import "testing"
+
+func TestParseDNSDumpsterTable(t *testing.T) {
+	body := []byte(`<table><tr><td>www.example.com</td></tr><tr><td>other.notexample.com</td></tr></table>`)
+
+	hosts := parseDNSDumpsterTable(body, "example.com")
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
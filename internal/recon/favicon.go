@@ -0,0 +1,151 @@
+package recon
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const faviconMaxBytes = 1024 * 1024 // 1MB, generous for an icon
+
+var faviconLinkRegex = regexp.MustCompile(`(?i)<link[^>]+rel=["']?(?:shortcut icon|icon)["']?[^>]*href=["']([^"']+)["']`)
+
+// faviconHash fetches the favicon for url/body (trying the <link rel="icon">
+// href first, falling back to /favicon.ico) and returns the httpx/Shodan-
+// compatible favicon hash: base64-encode the raw bytes, then MurmurHash3
+// (32-bit, seed 0) the base64 text. Returns "" if no favicon could be
+// fetched.
+func faviconHash(ctx context.Context, client *http.Client, pageURL, body, userAgent string) string {
+	iconURL := resolveFaviconURL(pageURL, body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, iconURL, nil)
+	if err != nil {
+		return ""
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ""
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, faviconMaxBytes))
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(data)
+	return fmtUint32(murmur3Hash32([]byte(encoded), 0))
+}
+
+// resolveFaviconURL finds the <link rel="icon"> href in body, resolving it
+// against pageURL, or falls back to pageURL's origin + /favicon.ico.
+func resolveFaviconURL(pageURL, body string) string {
+	origin := pageURL
+	if idx := strings.Index(pageURL[strings.Index(pageURL, "://")+3:], "/"); idx >= 0 {
+		origin = pageURL[:strings.Index(pageURL, "://")+3+idx]
+	}
+
+	if matches := faviconLinkRegex.FindStringSubmatch(body); len(matches) > 1 {
+		href := matches[1]
+		switch {
+		case strings.HasPrefix(href, "http://"), strings.HasPrefix(href, "https://"):
+			return href
+		case strings.HasPrefix(href, "//"):
+			scheme := origin[:strings.Index(origin, "://")]
+			return scheme + ":" + href
+		case strings.HasPrefix(href, "/"):
+			return origin + href
+		default:
+			return origin + "/" + href
+		}
+	}
+
+	return origin + "/favicon.ico"
+}
+
+// fmtUint32 renders u as a decimal string, matching the convention used by
+// httpx/Shodan favicon hash output (a signed 32-bit decimal, since the hash
+// is conventionally interpreted as int32).
+func fmtUint32(u uint32) string {
+	return int32ToString(int32(u))
+}
+
+func int32ToString(i int32) string {
+	if i == 0 {
+		return "0"
+	}
+	neg := i < 0
+	var buf [16]byte
+	pos := len(buf)
+	n := int64(i)
+	if neg {
+		n = -n
+	}
+	for n > 0 {
+		pos--
+		buf[pos] = byte('0' + n%10)
+		n /= 10
+	}
+	if neg {
+		pos--
+		buf[pos] = '-'
+	}
+	return string(buf[pos:])
+}
+
+// murmur3Hash32 is a from-scratch implementation of 32-bit MurmurHash3
+// (x86 variant), since the repo has no external hashing dependency
+// available for this one call site.
+func murmur3Hash32(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+
+	h := seed
+	nblocks := len(data) / 4
+
+	for i := 0; i < nblocks; i++ {
+		k := uint32(data[i*4]) | uint32(data[i*4+1])<<8 | uint32(data[i*4+2])<<16 | uint32(data[i*4+3])<<24
+		k *= c1
+		k = (k << 15) | (k >> 17)
+		k *= c2
+
+		h ^= k
+		h = (h << 13) | (h >> 19)
+		h = h*5 + 0xe6546b64
+	}
+
+	tail := data[nblocks*4:]
+	var k1 uint32
+	switch len(tail) {
+	case 3:
+		k1 ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint32(tail[0])
+		k1 *= c1
+		k1 = (k1 << 15) | (k1 >> 17)
+		k1 *= c2
+		h ^= k1
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+
+	return h
+}
@@ -0,0 +1,62 @@
+package recon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFaviconHash_MatchesKnownMurmur3Value(t *testing.T) {
+	// base64("hi") == "aGk=", murmur3_32("aGk=", seed=0) == 3645411702.
+	got := murmur3Hash32([]byte("aGk="), 0)
+	want := uint32(3645411702)
+	if got != want {
+		t.Errorf("murmur3Hash32(%q) = %d, want %d", "aGk=", got, want)
+	}
+}
+
+func TestFaviconHash_FetchesFaviconIcoByDefault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/favicon.ico" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("hi"))
+	}))
+	defer srv.Close()
+
+	hash := faviconHash(context.Background(), srv.Client(), srv.URL, "<html></html>", "test-agent")
+	if hash == "" {
+		t.Fatal("expected a non-empty favicon hash")
+	}
+}
+
+func TestFaviconHash_UsesLinkRelIconHref(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/static/icon.png" {
+			w.Write([]byte("hi"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	body := `<html><head><link rel="icon" href="/static/icon.png"></head></html>`
+	hash := faviconHash(context.Background(), srv.Client(), srv.URL, body, "test-agent")
+	if hash == "" {
+		t.Fatal("expected a non-empty favicon hash")
+	}
+}
+
+func TestFaviconHash_EmptyWhenNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	hash := faviconHash(context.Background(), srv.Client(), srv.URL, "<html></html>", "test-agent")
+	if hash != "" {
+		t.Errorf("hash = %q, want empty", hash)
+	}
+}
@@ -3,7 +3,11 @@ package recon
 import (
 	_ "embed"
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -13,41 +17,295 @@ import (
 //go:embed fingerprints.json
 var fingerprintsJSON []byte
 
-// FingerprintRule defines a pattern-matching rule for technology detection.
-type FingerprintRule struct {
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	Headers  []headerMatch  `json:"headers,omitempty"`
-	Body     []string       `json:"body,omitempty"`
-	Cookies  []string       `json:"cookies,omitempty"`
+// versionPattern is a compiled Wappalyzer-style pattern: a regex plus an
+// optional "\1"-style backreference into a semicolon-delimited version
+// directive, e.g. "nginx(?:/([\d.]+))?\;version:\1".
+type versionPattern struct {
+	regex      *regexp.Regexp
+	versionRef int // capture group index, 0 if the pattern carries no version directive
+}
+
+func compilePattern(raw string) versionPattern {
+	parts := strings.Split(raw, `\;`)
+	re, err := regexp.Compile("(?i)" + parts[0])
+	if err != nil {
+		return versionPattern{}
+	}
+
+	vp := versionPattern{regex: re}
+	for _, directive := range parts[1:] {
+		if !strings.HasPrefix(directive, "version:") {
+			continue
+		}
+		ref := strings.TrimPrefix(strings.TrimPrefix(directive, "version:"), `\`)
+		if n, err := strconv.Atoi(ref); err == nil {
+			vp.versionRef = n
+		}
+	}
+	return vp
+}
+
+// match reports whether s satisfies the pattern and, if so, the version
+// extracted via the pattern's backreference (empty if none).
+func (vp versionPattern) match(s string) (bool, string) {
+	if vp.regex == nil {
+		return false, ""
+	}
+	m := vp.regex.FindStringSubmatch(s)
+	if m == nil {
+		return false, ""
+	}
+	if vp.versionRef > 0 && vp.versionRef < len(m) {
+		return true, m[vp.versionRef]
+	}
+	return true, ""
 }
 
 type headerMatch struct {
-	Name    string `json:"name"`
-	Pattern string `json:"pattern"`
-	regex   *regexp.Regexp
+	name    string
+	pattern versionPattern
+}
+
+type metaMatch struct {
+	name    string
+	pattern versionPattern
+}
+
+// FingerprintRule is the compiled, in-memory form of a technology
+// definition — built from either the module's legacy JSON schema or an
+// imported Wappalyzer technology-catalog entry.
+type FingerprintRule struct {
+	Name     string
+	Category string
+	Website  string
+
+	Headers   []headerMatch
+	Cookies   []headerMatch
+	HTML      []versionPattern
+	Scripts   []versionPattern
+	ScriptSrc []versionPattern
+	Meta      []metaMatch
+
+	Implies  []string
+	Requires []string
+	Excludes []string
 }
 
 var (
 	fingerprintRules []FingerprintRule
 	fingerprintOnce  sync.Once
+	fingerprintMu    sync.Mutex
 )
 
 func loadFingerprints() {
 	fingerprintOnce.Do(func() {
-		if err := json.Unmarshal(fingerprintsJSON, &fingerprintRules); err != nil {
+		rules, err := parseFingerprintBundle(fingerprintsJSON)
+		if err != nil {
 			return
 		}
-		// Pre-compile header regexes.
-		for i := range fingerprintRules {
-			for j := range fingerprintRules[i].Headers {
-				h := &fingerprintRules[i].Headers[j]
-				if h.Pattern != "" {
-					h.regex, _ = regexp.Compile("(?i)" + h.Pattern)
-				}
+		fingerprintRules = rules
+	})
+}
+
+// LoadFingerprintsDir replaces the active rule set with every *.json file in
+// dir, each parsed as either the legacy custom schema or a Wappalyzer
+// technologies bundle (an optional sibling categories.json resolves
+// category ids to names). This lets operators swap the ~40 built-in rules
+// for a community-maintained Wappalyzer checkout without recompiling.
+func LoadFingerprintsDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("read fingerprints dir %s: %w", dir, err)
+	}
+
+	categories := loadCategories(filepath.Join(dir, "categories.json"))
+
+	var rules []FingerprintRule
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") || e.Name() == "categories.json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		parsed, err := parseFingerprintBundle(data)
+		if err != nil {
+			continue
+		}
+		for i := range parsed {
+			if parsed[i].Category == "" {
+				continue
+			}
+			if name, ok := categories[parsed[i].Category]; ok {
+				parsed[i].Category = name
 			}
 		}
-	})
+		rules = append(rules, parsed...)
+	}
+
+	if len(rules) == 0 {
+		return fmt.Errorf("no fingerprint rules found in %s", dir)
+	}
+
+	// Run the one-time built-in load now so it can't overwrite this set later.
+	loadFingerprints()
+	fingerprintMu.Lock()
+	fingerprintRules = rules
+	fingerprintMu.Unlock()
+	return nil
+}
+
+func loadCategories(path string) map[string]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var raw map[string]struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	out := make(map[string]string, len(raw))
+	for id, cat := range raw {
+		out[id] = cat.Name
+	}
+	return out
+}
+
+// parseFingerprintBundle detects whether data is the module's legacy array
+// schema or a Wappalyzer technologies.json object and compiles it either way.
+func parseFingerprintBundle(data []byte) ([]FingerprintRule, error) {
+	trimmed := strings.TrimLeft(string(data), " \t\r\n")
+	if strings.HasPrefix(trimmed, "[") {
+		return parseLegacyRules(data)
+	}
+	return parseWappalyzerRules(data)
+}
+
+// legacyRule mirrors the module's original custom schema: a flat array with
+// header name/pattern pairs and plain body/cookie substrings.
+type legacyRule struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Headers  []struct {
+		Name    string `json:"name"`
+		Pattern string `json:"pattern"`
+	} `json:"headers,omitempty"`
+	Body    []string `json:"body,omitempty"`
+	Cookies []string `json:"cookies,omitempty"`
+}
+
+func parseLegacyRules(data []byte) ([]FingerprintRule, error) {
+	var raw []legacyRule
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	rules := make([]FingerprintRule, 0, len(raw))
+	for _, r := range raw {
+		rule := FingerprintRule{Name: r.Name, Category: r.Category}
+		for _, h := range r.Headers {
+			pattern := versionPattern{}
+			if h.Pattern != "" {
+				pattern = compilePattern(h.Pattern)
+			} else {
+				// Bare presence check: match any non-empty header value.
+				pattern.regex = regexp.MustCompile(`.+`)
+			}
+			rule.Headers = append(rule.Headers, headerMatch{name: strings.ToLower(h.Name), pattern: pattern})
+		}
+		for _, substr := range r.Body {
+			rule.HTML = append(rule.HTML, versionPattern{regex: regexp.MustCompile("(?i)" + regexp.QuoteMeta(substr))})
+		}
+		for _, name := range r.Cookies {
+			rule.Cookies = append(rule.Cookies, headerMatch{name: strings.ToLower(name), pattern: versionPattern{regex: regexp.MustCompile(`.*`)}})
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// stringOrSlice decodes a Wappalyzer field that may be either a bare string
+// or an array of strings.
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		if single != "" {
+			*s = []string{single}
+		}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(data, &multi); err != nil {
+		return err
+	}
+	*s = multi
+	return nil
+}
+
+type wappalyzerEntry struct {
+	Cats      []int                    `json:"cats"`
+	Headers   map[string]string        `json:"headers"`
+	Cookies   map[string]string        `json:"cookies"`
+	HTML      stringOrSlice            `json:"html"`
+	Scripts   stringOrSlice            `json:"scripts"`
+	ScriptSrc stringOrSlice            `json:"scriptSrc"`
+	Meta      map[string]stringOrSlice `json:"meta"`
+	Implies   stringOrSlice            `json:"implies"`
+	Requires  stringOrSlice            `json:"requires"`
+	Excludes  stringOrSlice            `json:"excludes"`
+	Website   string                   `json:"website"`
+
+	// DOM and JS describe checks against a live, script-executed page.
+	// Sweep has no headless browser/JS engine, so entries using them are
+	// parsed (to keep the bundle loadable) but never matched.
+}
+
+func parseWappalyzerRules(data []byte) ([]FingerprintRule, error) {
+	var raw map[string]wappalyzerEntry
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	rules := make([]FingerprintRule, 0, len(raw))
+	for name, entry := range raw {
+		rule := FingerprintRule{
+			Name:     name,
+			Website:  entry.Website,
+			Implies:  entry.Implies,
+			Requires: entry.Requires,
+			Excludes: entry.Excludes,
+		}
+		if len(entry.Cats) > 0 {
+			rule.Category = strconv.Itoa(entry.Cats[0])
+		}
+		for hname, pattern := range entry.Headers {
+			rule.Headers = append(rule.Headers, headerMatch{name: strings.ToLower(hname), pattern: compilePattern(pattern)})
+		}
+		for cname, pattern := range entry.Cookies {
+			rule.Cookies = append(rule.Cookies, headerMatch{name: strings.ToLower(cname), pattern: compilePattern(pattern)})
+		}
+		for _, p := range entry.HTML {
+			rule.HTML = append(rule.HTML, compilePattern(p))
+		}
+		for _, p := range entry.Scripts {
+			rule.Scripts = append(rule.Scripts, compilePattern(p))
+		}
+		for _, p := range entry.ScriptSrc {
+			rule.ScriptSrc = append(rule.ScriptSrc, compilePattern(p))
+		}
+		for mname, patterns := range entry.Meta {
+			for _, p := range patterns {
+				rule.Meta = append(rule.Meta, metaMatch{name: strings.ToLower(mname), pattern: compilePattern(p)})
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
 }
 
 // FingerprintServices applies technology fingerprinting to HTTP services.
@@ -55,74 +313,178 @@ func loadFingerprints() {
 func FingerprintServices(services []engine.HTTPService, probeResults map[string]*probeData) {
 	loadFingerprints()
 
+	fingerprintMu.Lock()
+	rules := fingerprintRules
+	fingerprintMu.Unlock()
+
+	byName := make(map[string]FingerprintRule, len(rules))
+	for _, r := range rules {
+		byName[r.Name] = r
+	}
+
 	for i := range services {
 		svc := &services[i]
-		var techs []engine.Technology
 
 		data := probeResults[svc.URL]
 		if data == nil {
 			// Fallback: fingerprint from headers only.
-			data = &probeData{
-				headers: map[string]string{},
-			}
+			data = &probeData{headers: map[string]string{}}
 			if svc.Server != "" {
 				data.headers["server"] = svc.Server
 			}
 		}
 
-		for _, rule := range fingerprintRules {
-			if matchesRule(rule, data) {
-				techs = append(techs, engine.Technology{
-					Name:     rule.Name,
-					Category: rule.Category,
-				})
+		matched := make(map[string]engine.Technology)
+		for _, rule := range rules {
+			if ok, version := matchesRule(rule, data); ok {
+				matched[rule.Name] = engine.Technology{Name: rule.Name, Category: rule.Category, Version: version}
 			}
 		}
 
+		applyImplies(matched, byName)
+		applyRequiresExcludes(matched, byName)
+
+		techs := make([]engine.Technology, 0, len(matched))
+		for _, t := range matched {
+			techs = append(techs, t)
+		}
 		svc.Technologies = techs
 	}
 }
 
+// applyImplies auto-adds technologies named in a matched rule's Implies list.
+func applyImplies(matched map[string]engine.Technology, byName map[string]FingerprintRule) {
+	queue := make([]string, 0, len(matched))
+	for name := range matched {
+		queue = append(queue, name)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		rule, ok := byName[name]
+		if !ok {
+			continue
+		}
+		for _, implied := range rule.Implies {
+			if _, exists := matched[implied]; exists {
+				continue
+			}
+			impliedRule, ok := byName[implied]
+			if !ok {
+				continue
+			}
+			matched[implied] = engine.Technology{Name: impliedRule.Name, Category: impliedRule.Category}
+			queue = append(queue, implied)
+		}
+	}
+}
+
+// applyRequiresExcludes drops matches whose Requires aren't satisfied or
+// whose Excludes are present among the other matches.
+func applyRequiresExcludes(matched map[string]engine.Technology, byName map[string]FingerprintRule) {
+	for name := range matched {
+		rule, ok := byName[name]
+		if !ok {
+			continue
+		}
+		for _, req := range rule.Requires {
+			if _, exists := matched[req]; !exists {
+				delete(matched, name)
+				break
+			}
+		}
+	}
+	for name := range matched {
+		rule, ok := byName[name]
+		if !ok {
+			continue
+		}
+		for _, excl := range rule.Excludes {
+			if _, exists := matched[excl]; exists {
+				delete(matched, name)
+				break
+			}
+		}
+	}
+}
+
 // probeData holds the raw HTTP response data for fingerprinting.
 type probeData struct {
 	headers map[string]string // lowercase header name → value
 	body    string
-	cookies []string // cookie names
+	cookies map[string]string // lowercase cookie name → value
 }
 
-func matchesRule(rule FingerprintRule, data *probeData) bool {
-	// Check header patterns.
+func matchesRule(rule FingerprintRule, data *probeData) (bool, string) {
 	for _, hm := range rule.Headers {
-		headerName := strings.ToLower(hm.Name)
-		headerVal, exists := data.headers[headerName]
-		if !exists {
-			continue
+		if val, ok := data.headers[hm.name]; ok {
+			if matched, version := hm.pattern.match(val); matched {
+				return true, version
+			}
 		}
-		if hm.regex != nil && hm.regex.MatchString(headerVal) {
-			return true
+	}
+
+	for _, cm := range rule.Cookies {
+		if val, ok := data.cookies[cm.name]; ok {
+			if matched, version := cm.pattern.match(val); matched {
+				return true, version
+			}
+		}
+	}
+
+	for _, p := range rule.HTML {
+		if matched, version := p.match(data.body); matched {
+			return true, version
+		}
+	}
+
+	for _, p := range rule.Scripts {
+		for _, src := range extractScriptSrcs(data.body) {
+			if matched, version := p.match(src); matched {
+				return true, version
+			}
 		}
-		if hm.Pattern == "" && headerVal != "" {
-			return true
+		if matched, version := p.match(data.body); matched {
+			return true, version
 		}
 	}
 
-	// Check body substrings.
-	bodyLower := strings.ToLower(data.body)
-	for _, substr := range rule.Body {
-		if strings.Contains(bodyLower, strings.ToLower(substr)) {
-			return true
+	for _, p := range rule.ScriptSrc {
+		for _, src := range extractScriptSrcs(data.body) {
+			if matched, version := p.match(src); matched {
+				return true, version
+			}
 		}
 	}
 
-	// Check cookie names.
-	for _, cookieName := range rule.Cookies {
-		cookieLower := strings.ToLower(cookieName)
-		for _, c := range data.cookies {
-			if strings.ToLower(c) == cookieLower {
-				return true
+	for _, mm := range rule.Meta {
+		for _, content := range extractMetaContent(data.body, mm.name) {
+			if matched, version := mm.pattern.match(content); matched {
+				return true, version
 			}
 		}
 	}
 
-	return false
+	return false, ""
+}
+
+var scriptSrcRegex = regexp.MustCompile(`(?i)<script[^>]+src=["']([^"']+)["']`)
+
+func extractScriptSrcs(body string) []string {
+	matches := scriptSrcRegex.FindAllStringSubmatch(body, -1)
+	srcs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		srcs = append(srcs, m[1])
+	}
+	return srcs
+}
+
+func extractMetaContent(body, name string) []string {
+	re := regexp.MustCompile(`(?i)<meta[^>]+name=["']` + regexp.QuoteMeta(name) + `["'][^>]+content=["']([^"']*)["']`)
+	matches := re.FindAllStringSubmatch(body, -1)
+	contents := make([]string, 0, len(matches))
+	for _, m := range matches {
+		contents = append(contents, m[1])
+	}
+	return contents
 }
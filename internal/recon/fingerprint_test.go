@@ -1,8 +1,6 @@
 package recon
 
 import (
-	"encoding/json"
-	"regexp"
 	"testing"
 
 	"github.com/vulnverified/sweep/internal/engine"
@@ -55,7 +53,7 @@ func TestFingerprintServices_DetectsTechnologies(t *testing.T) {
 			name: "Laravel from cookies",
 			data: &probeData{
 				headers: map[string]string{},
-				cookies: []string{"laravel_session"},
+				cookies: map[string]string{"laravel_session": "abc123"},
 			},
 			wantTech: []string{"Laravel"},
 		},
@@ -110,8 +108,8 @@ func TestFingerprintServices_DetectsTechnologies(t *testing.T) {
 }
 
 func TestFingerprintDB_ValidJSON(t *testing.T) {
-	var rules []FingerprintRule
-	if err := json.Unmarshal(fingerprintsJSON, &rules); err != nil {
+	rules, err := parseFingerprintBundle(fingerprintsJSON)
+	if err != nil {
 		t.Fatalf("invalid fingerprints.json: %v", err)
 	}
 
@@ -119,18 +117,90 @@ func TestFingerprintDB_ValidJSON(t *testing.T) {
 		t.Errorf("expected at least 40 rules, got %d", len(rules))
 	}
 
-	// Verify all header regexes compile.
+	// Every header pattern must have compiled successfully.
 	for _, rule := range rules {
 		for _, h := range rule.Headers {
-			if h.Pattern != "" {
-				if _, err := regexp.Compile("(?i)" + h.Pattern); err != nil {
-					t.Errorf("rule %q: invalid regex %q: %v", rule.Name, h.Pattern, err)
-				}
+			if h.pattern.regex == nil {
+				t.Errorf("rule %q: header pattern for %q failed to compile", rule.Name, h.name)
 			}
 		}
 	}
 }
 
+func TestParseWappalyzerRules_VersionCapture(t *testing.T) {
+	bundle := []byte(`{
+		"nginx": {
+			"cats": [22],
+			"headers": {"Server": "nginx(?:/([\\d.]+))?\\;version:\\1"}
+		},
+		"React": {"cats": [12]},
+		"Next.js": {"cats": [18], "implies": ["React"]}
+	}`)
+
+	rules, err := parseWappalyzerRules(bundle)
+	if err != nil {
+		t.Fatalf("parseWappalyzerRules: %v", err)
+	}
+
+	byName := make(map[string]FingerprintRule, len(rules))
+	for _, r := range rules {
+		byName[r.Name] = r
+	}
+
+	nginx, ok := byName["nginx"]
+	if !ok {
+		t.Fatal("missing nginx rule")
+	}
+	if len(nginx.Headers) != 1 {
+		t.Fatalf("expected 1 header matcher, got %d", len(nginx.Headers))
+	}
+	matched, version := nginx.Headers[0].pattern.match("nginx/1.24.0")
+	if !matched {
+		t.Fatal("expected server header to match")
+	}
+	if version != "1.24.0" {
+		t.Errorf("version = %q, want %q", version, "1.24.0")
+	}
+
+	next, ok := byName["Next.js"]
+	if !ok || len(next.Implies) != 1 || next.Implies[0] != "React" {
+		t.Errorf("Next.js implies = %v, want [React]", next.Implies)
+	}
+}
+
+func TestFingerprintServices_Implies(t *testing.T) {
+	services := []engine.HTTPService{{URL: "http://test.com", Host: "test.com"}}
+	probeResults := map[string]*probeData{
+		"http://test.com": {headers: map[string]string{"server": "nginx/1.24.0"}},
+	}
+
+	// Load the bundle first so FingerprintServices' one-time load can't
+	// replace the rules installed below.
+	loadFingerprints()
+	fingerprintMu.Lock()
+	prior := fingerprintRules
+	fingerprintRules = []FingerprintRule{
+		{Name: "nginx", Headers: []headerMatch{{name: "server", pattern: compilePattern(`nginx`)}}, Implies: []string{"Linux"}},
+		{Name: "Linux", Category: "os"},
+	}
+	fingerprintMu.Unlock()
+	defer func() {
+		fingerprintMu.Lock()
+		fingerprintRules = prior
+		fingerprintMu.Unlock()
+	}()
+
+	FingerprintServices(services, probeResults)
+
+	techNames := make(map[string]bool)
+	for _, tech := range services[0].Technologies {
+		techNames[tech.Name] = true
+	}
+	if !techNames["nginx"] || !techNames["Linux"] {
+		t.Errorf("expected nginx to imply Linux, got %v", services[0].Technologies)
+	}
+}
+
 func TestFingerprintDB_HasRequiredCategories(t *testing.T) {
 	loadFingerprints()
 
@@ -0,0 +1,90 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	githubSearchURL = "https://api.github.com/search/code?q=%s+in:file&per_page=100"
+	githubTimeout   = 20 * time.Second
+	githubMaxBody   = 10 * 1024 * 1024 // 10MB
+)
+
+type githubSearchResponse struct {
+	Items []struct {
+		TextMatches []struct {
+			Fragment string `json:"fragment"`
+		} `json:"text_matches"`
+	} `json:"items"`
+}
+
+// githubHostRegex extracts hostname-shaped substrings from the fragment
+// text GitHub's code-search API returns around each match; results are
+// then filtered down to ones actually rooted at the target domain.
+var githubHostRegex = regexp.MustCompile(`(?i)[a-z0-9](?:[a-z0-9-]*[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)+`)
+
+// GitHubEnumerate searches GitHub code for files that mention the target
+// domain and regex-scrapes hostnames out of the returned match fragments.
+// Requires a personal access token; returns an error if apiToken is empty.
+// The code-search API only returns short fragments around each match (not
+// full file contents), so this finds far fewer subdomains than grepping a
+// local clone of every matched repo would — an intentionally scoped
+// approximation of what subfinder's GitHub source does.
+func GitHubEnumerate(ctx context.Context, domain, apiToken string) ([]string, error) {
+	if apiToken == "" {
+		return nil, fmt.Errorf("github: no API token configured")
+	}
+
+	url := fmt.Sprintf(githubSearchURL, domain)
+	headers := map[string]string{
+		"Authorization": "token " + apiToken,
+		"Accept":        "application/vnd.github.v3.text-match+json",
+	}
+	body, err := sourceHTTPGet(ctx, url, headers, githubTimeout, githubMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("github fetch for %s: %w", domain, err)
+	}
+
+	return parseGitHubSearchResponse(body, domain)
+}
+
+// parseGitHubSearchResponse extracts in-scope hostnames from a GitHub
+// code-search response's text-match fragments.
+func parseGitHubSearchResponse(body []byte, domain string) ([]string, error) {
+	var resp githubSearchResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("github JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, item := range resp.Items {
+		for _, match := range item.TextMatches {
+			for _, m := range githubHostRegex.FindAllString(match.Fragment, -1) {
+				host := strings.ToLower(m)
+				if !strings.HasSuffix(host, "."+domain) && host != domain {
+					continue
+				}
+				if !seen[host] {
+					seen[host] = true
+					hosts = append(hosts, host)
+				}
+			}
+		}
+	}
+	return hosts, nil
+}
+
+// githubSource adapts GitHubEnumerate to PassiveSource.
+type githubSource struct{ APIToken string }
+
+func (s *githubSource) Name() string  { return "github" }
+func (s *githubSource) Enabled() bool { return s.APIToken != "" }
+func (s *githubSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return GitHubEnumerate(ctx, domain, s.APIToken)
+}
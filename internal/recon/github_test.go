@@ -0,0 +1,21 @@
+package recon
+
+import "testing"
+
+func TestGitHubEnumerateRequiresAPIToken(t *testing.T) {
+	if _, err := GitHubEnumerate(nil, "example.com", ""); err == nil {
+		t.Fatal("expected error with no API token")
+	}
+}
+
+func TestParseGitHubSearchResponseFiltersToScope(t *testing.T) {
+	body := []byte(`{"items":[{"text_matches":[{"fragment":"curl https://www.example.com/api and https://other.notexample.com/"}]}]}`)
+
+	hosts, err := parseGitHubSearchResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
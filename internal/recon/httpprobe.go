@@ -3,6 +3,7 @@ package recon
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,7 +28,9 @@ type HTTPProbeResult struct {
 // HTTPProbe probes open ports for HTTP/HTTPS services.
 // For ports 443, 8443, 9443: tries HTTPS first.
 // For all others: tries HTTP first, falls back to HTTPS.
-func HTTPProbe(ctx context.Context, openPorts []engine.PortResult, concurrency int, timeout time.Duration, userAgent string) (*HTTPProbeResult, error) {
+// When jarm is true, every HTTPS service also gets a JARM fingerprint,
+// which costs ten extra handshakes per target.
+func HTTPProbe(ctx context.Context, openPorts []engine.PortResult, concurrency int, timeout time.Duration, userAgent string, jarm bool) (*HTTPProbeResult, error) {
 	work := make(chan engine.PortResult, len(openPorts))
 	for _, p := range openPorts {
 		work <- p
@@ -66,7 +69,7 @@ func HTTPProbe(ctx context.Context, openPorts []engine.PortResult, concurrency i
 				default:
 				}
 
-				svc, pd := probePort(ctx, client, pr, userAgent)
+				svc, pd := probePort(ctx, client, pr, userAgent, timeout, jarm)
 				if svc == nil {
 					continue
 				}
@@ -85,12 +88,24 @@ func HTTPProbe(ctx context.Context, openPorts []engine.PortResult, concurrency i
 	return result, nil
 }
 
+// tlsCertInfoFrom extracts the identifying fields from an HTTPS target's
+// leaf certificate.
+func tlsCertInfoFrom(cert *x509.Certificate) *engine.TLSCertInfo {
+	return &engine.TLSCertInfo{
+		CommonName: cert.Subject.CommonName,
+		SANs:       cert.DNSNames,
+		Issuer:     cert.Issuer.CommonName,
+		NotBefore:  cert.NotBefore,
+		NotAfter:   cert.NotAfter,
+	}
+}
+
 // tlsFirstPorts are ports where HTTPS should be tried before HTTP.
 var tlsFirstPorts = map[int]bool{
 	443: true, 8443: true, 9443: true, 6443: true, 1443: true, 4443: true,
 }
 
-func probePort(ctx context.Context, client *http.Client, pr engine.PortResult, userAgent string) (*engine.HTTPService, *probeData) {
+func probePort(ctx context.Context, client *http.Client, pr engine.PortResult, userAgent string, timeout time.Duration, jarm bool) (*engine.HTTPService, *probeData) {
 	schemes := []string{"http", "https"}
 	if tlsFirstPorts[pr.Port] {
 		schemes = []string{"https", "http"}
@@ -98,7 +113,7 @@ func probePort(ctx context.Context, client *http.Client, pr engine.PortResult, u
 
 	for _, scheme := range schemes {
 		url := fmt.Sprintf("%s://%s:%d", scheme, pr.Host, pr.Port)
-		svc, pd := probeURL(ctx, client, url, pr, scheme, userAgent)
+		svc, pd := probeURL(ctx, client, url, pr, scheme, userAgent, timeout, jarm)
 		if svc != nil {
 			return svc, pd
 		}
@@ -106,7 +121,7 @@ func probePort(ctx context.Context, client *http.Client, pr engine.PortResult, u
 	return nil, nil
 }
 
-func probeURL(ctx context.Context, client *http.Client, url string, pr engine.PortResult, scheme, userAgent string) (*engine.HTTPService, *probeData) {
+func probeURL(ctx context.Context, client *http.Client, url string, pr engine.PortResult, scheme, userAgent string, timeout time.Duration, jarm bool) (*engine.HTTPService, *probeData) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, nil
@@ -138,6 +153,17 @@ func probeURL(ctx context.Context, client *http.Client, url string, pr engine.Po
 		svc.Title = strings.TrimSpace(string(matches[1]))
 	}
 
+	svc.FaviconHash = faviconHash(ctx, client, url, bodyStr, userAgent)
+
+	if scheme == "https" {
+		if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+			svc.TLSCert = tlsCertInfoFrom(resp.TLS.PeerCertificates[0])
+		}
+		if jarm {
+			svc.JARM = jarmFingerprint(ctx, pr.Host, pr.Port, timeout)
+		}
+	}
+
 	// Build probe data for fingerprinting.
 	headers := make(map[string]string)
 	for name, vals := range resp.Header {
@@ -146,15 +172,15 @@ func probeURL(ctx context.Context, client *http.Client, url string, pr engine.Po
 		}
 	}
 
-	var cookieNames []string
+	cookies := make(map[string]string)
 	for _, c := range resp.Cookies() {
-		cookieNames = append(cookieNames, c.Name)
+		cookies[c.Name] = c.Value
 	}
 
 	pd := &probeData{
 		headers: headers,
 		body:    bodyStr,
-		cookies: cookieNames,
+		cookies: cookies,
 	}
 
 	return svc, pd
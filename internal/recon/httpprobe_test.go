@@ -26,7 +26,7 @@ func TestHTTPProbe_ExtractsMetadata(t *testing.T) {
 		{Host: "127.0.0.1", IP: "127.0.0.1", Port: port},
 	}
 
-	result, err := HTTPProbe(context.Background(), openPorts, 2, 5*time.Second, "test-agent")
+	result, err := HTTPProbe(context.Background(), openPorts, 2, 5*time.Second, "test-agent", false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -53,7 +53,7 @@ func TestHTTPProbe_HandlesNonHTTP(t *testing.T) {
 		{Host: "127.0.0.1", IP: "127.0.0.1", Port: 1},
 	}
 
-	result, err := HTTPProbe(context.Background(), openPorts, 2, 500*time.Millisecond, "test-agent")
+	result, err := HTTPProbe(context.Background(), openPorts, 2, 500*time.Millisecond, "test-agent", false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -0,0 +1,17 @@
+package recon
+
+import (
+	"context"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// IPEnumerator implements engine.SubdomainEnumerator for a target that's
+// already a concrete IP address (from a CIDR block or ASN expansion). It
+// returns the IP itself without touching any passive-DNS source, keeping
+// those domain-scoped rather than querying crt.sh et al. for an IP.
+type IPEnumerator struct{}
+
+func (IPEnumerator) Enumerate(ctx context.Context, domain string, concurrency int) ([]engine.Subdomain, error) {
+	return []engine.Subdomain{{Host: domain, Sources: []string{"direct"}}}, nil
+}
@@ -0,0 +1,86 @@
+package recon
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// jarmProbes describes the ClientHello variations JARM sends to a target.
+// True JARM crafts raw ClientHellos byte-for-byte (controlling cipher and
+// extension *order* independently of what any TLS stack would offer). Go's
+// crypto/tls does not expose that level of control, so this is an honest
+// approximation: it varies the dimensions crypto/tls does expose (TLS
+// version ceiling, cipher suite list, ALPN protocols) across ten handshakes
+// and hashes the ordered list of negotiated-parameter responses. The
+// resulting value is stable for a given server configuration and useful for
+// clustering/pivoting, but is not byte-compatible with upstream JARM output.
+var jarmProbes = []struct {
+	maxVersion uint16
+	ciphers    []uint16
+	alpn       []string
+}{
+	{tls.VersionTLS12, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, []string{"http/1.1"}},
+	{tls.VersionTLS12, []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305, tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305}, []string{"h2", "http/1.1"}},
+	{tls.VersionTLS13, nil, []string{"h2"}},
+	{tls.VersionTLS13, nil, []string{"http/1.1"}},
+	{tls.VersionTLS12, []uint16{tls.TLS_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_RSA_WITH_AES_128_GCM_SHA256}, nil},
+	{tls.VersionTLS11, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA}, nil},
+	{tls.VersionTLS12, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, []string{"h2"}},
+	{tls.VersionTLS12, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}, []string{"http/1.1"}},
+	{tls.VersionTLS13, nil, nil},
+	{tls.VersionTLS12, []uint16{tls.TLS_RSA_WITH_AES_128_CBC_SHA}, nil},
+}
+
+// jarmFingerprint dials host:port with each of the jarmProbes ClientHello
+// variations and hashes the ordered list of responses (negotiated version,
+// cipher, and ALPN protocol, or "|||" for a failed/refused handshake). Returns
+// "" if every probe fails (e.g. the port doesn't speak TLS at all).
+func jarmFingerprint(ctx context.Context, host string, port int, timeout time.Duration) string {
+	addr := net.JoinHostPort(host, fmt.Sprintf("%d", port))
+	var responses []string
+	ok := false
+
+	for _, probe := range jarmProbes {
+		dialCtx, cancel := context.WithTimeout(ctx, timeout)
+		d := &net.Dialer{}
+		conn, err := d.DialContext(dialCtx, "tcp", addr)
+		cancel()
+		if err != nil {
+			responses = append(responses, "|||")
+			continue
+		}
+
+		cfg := &tls.Config{
+			InsecureSkipVerify: true,
+			MaxVersion:         probe.maxVersion,
+			CipherSuites:       probe.ciphers,
+			NextProtos:         probe.alpn,
+		}
+		tlsConn := tls.Client(conn, cfg)
+		tlsConn.SetDeadline(time.Now().Add(timeout))
+
+		if err := tlsConn.Handshake(); err != nil {
+			tlsConn.Close()
+			responses = append(responses, "|||")
+			continue
+		}
+
+		state := tlsConn.ConnectionState()
+		responses = append(responses, fmt.Sprintf("%d|%d|%s", state.Version, state.CipherSuite, state.NegotiatedProtocol))
+		tlsConn.Close()
+		ok = true
+	}
+
+	if !ok {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(responses, ",")))
+	return hex.EncodeToString(sum[:])
+}
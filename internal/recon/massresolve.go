@@ -0,0 +1,439 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const (
+	massQueryTimeout   = 4 * time.Second
+	massMaxRetries     = 3
+	massRetryBaseDelay = 250 * time.Millisecond
+	massDefaultQPS     = 1000
+	massSockets        = 4
+	massRetryTick      = 50 * time.Millisecond
+)
+
+// massQueryTypes are issued for every candidate host. CNAME is
+// best-effort (a miss just means "no CNAME"); A and AAAA determine
+// whether the host is live.
+var massQueryTypes = []uint16{dns.TypeCNAME, dns.TypeA, dns.TypeAAAA}
+
+// MassResolver is a high-throughput plain-DNS resolver built for
+// brute-force enumeration against wordlists of hundreds to thousands of
+// candidate hostnames. UDPResolver (and resolveHostsConcurrently more
+// generally) fan work out across a worker pool where each goroutine
+// blocks on one synchronous query at a time — at realistic concurrency
+// that caps out in the low hundreds of qps, dominated by goroutine and
+// syscall overhead. MassResolver instead pipelines: a writer sends query
+// packets across a small pool of UDP sockets as fast as each upstream's
+// rate limiter allows, a reader demultiplexes replies by DNS transaction
+// ID, and a retransmit loop retries anything that hasn't answered within
+// a backoff window. This sustains 10k+ qps against a handful of
+// upstreams instead of a few hundred.
+type MassResolver struct {
+	// Upstreams are "host:port" plain-DNS resolvers, round-robined
+	// per query.
+	Upstreams []string
+	// QPS caps each upstream's query rate. 0 uses massDefaultQPS.
+	QPS int
+}
+
+// NewMassResolver returns a MassResolver round-robining across addrs.
+func NewMassResolver(addrs []string, qps int) *MassResolver {
+	return &MassResolver{Upstreams: addrs, QPS: qps}
+}
+
+// inFlight tracks one query's retry state, keyed by its 16-bit DNS
+// transaction ID. Entries are evicted as soon as the reader sees a
+// matching reply or the retransmit loop exhausts their retries, so memory stays
+// bounded by the number of truly in-flight queries, not total queries
+// issued.
+type inFlight struct {
+	host     string
+	qtype    uint16
+	upstream int
+	sentAt   time.Time
+	tries    int
+}
+
+// hostProgress tracks how many of a host's queries (CNAME/A/AAAA) are
+// still outstanding, plus what's been learned so far.
+type hostProgress struct {
+	remaining int
+	ips       []string
+	cname     string
+	lastErr   error
+}
+
+// Resolve implements engine.DNSResolver.
+func (m *MassResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	if len(m.Upstreams) == 0 {
+		return nil, nil, fmt.Errorf("mass resolver: no upstreams configured")
+	}
+	if len(hosts) == 0 {
+		return nil, nil, nil
+	}
+
+	conns := make([]net.PacketConn, massSockets)
+	for i := range conns {
+		conn, err := net.ListenPacket("udp", ":0")
+		if err != nil {
+			for _, c := range conns[:i] {
+				c.Close()
+			}
+			return nil, nil, fmt.Errorf("mass resolver: open socket: %w", err)
+		}
+		conns[i] = conn
+	}
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+
+	qps := m.QPS
+	if qps <= 0 {
+		qps = massDefaultQPS
+	}
+	limiters := make([]*rateLimiter, len(m.Upstreams))
+	for i := range limiters {
+		limiters[i] = newRateLimiter(qps)
+	}
+	defer func() {
+		for _, l := range limiters {
+			l.stop()
+		}
+	}()
+
+	state := &massState{
+		upstreams: m.Upstreams,
+		conns:     conns,
+		limiters:  limiters,
+		pending:   make(map[uint16]*inFlight),
+		progress:  make(map[string]*hostProgress, len(hosts)),
+		done:      make(chan struct{}),
+	}
+
+	for _, h := range hosts {
+		state.progress[h] = &hostProgress{remaining: len(massQueryTypes)}
+	}
+
+	var readerWG sync.WaitGroup
+	for _, conn := range conns {
+		readerWG.Add(1)
+		go func(conn net.PacketConn) {
+			defer readerWG.Done()
+			state.readLoop(conn)
+		}(conn)
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		state.sendAll(ctx, hosts)
+		state.retransmitUntilDrained(ctx)
+	}()
+
+	select {
+	case <-finished:
+	case <-ctx.Done():
+	}
+	close(state.done)
+	readerWG.Wait()
+
+	return state.collect(hosts)
+}
+
+// massState holds the shared, mutex-protected bookkeeping a MassResolver
+// run threads between its sender, retransmitter, and per-socket readers.
+type massState struct {
+	upstreams []string
+	conns     []net.PacketConn
+	limiters  []*rateLimiter
+
+	mu       sync.Mutex
+	nextID   uint16
+	pending  map[uint16]*inFlight
+	progress map[string]*hostProgress
+
+	done chan struct{}
+}
+
+// allocID returns an unused 16-bit transaction ID. Callers hold mu.
+func (s *massState) allocID() uint16 {
+	for {
+		s.nextID++
+		if _, taken := s.pending[s.nextID]; !taken {
+			return s.nextID
+		}
+	}
+}
+
+// sendAll issues the initial CNAME/A/AAAA queries for every host,
+// round-robining across upstreams and sockets and pacing each upstream
+// through its rate limiter.
+func (s *massState) sendAll(ctx context.Context, hosts []string) {
+	upstream := 0
+	for _, host := range hosts {
+		for _, qtype := range massQueryTypes {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.done:
+				return
+			default:
+			}
+			s.limiters[upstream%len(s.upstreams)].wait(ctx)
+			s.send(host, qtype, upstream%len(s.upstreams))
+			upstream++
+		}
+	}
+}
+
+// send encodes and writes one query, registering it in the pending map
+// under a fresh transaction ID.
+func (s *massState) send(host string, qtype uint16, upstreamIdx int) {
+	msg := new(dns.Msg)
+	msg.RecursionDesired = true
+
+	s.mu.Lock()
+	id := s.allocID()
+	msg.Id = id
+	s.pending[id] = &inFlight{host: host, qtype: qtype, upstream: upstreamIdx, sentAt: time.Now(), tries: 1}
+	s.mu.Unlock()
+
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+
+	packed, err := msg.Pack()
+	if err != nil {
+		s.finishQuery(id, nil, err)
+		return
+	}
+
+	addr, err := net.ResolveUDPAddr("udp", s.upstreams[upstreamIdx])
+	if err != nil {
+		s.finishQuery(id, nil, err)
+		return
+	}
+
+	conn := s.conns[int(id)%len(s.conns)]
+	if _, err := conn.WriteTo(packed, addr); err != nil {
+		s.finishQuery(id, nil, err)
+	}
+}
+
+// readLoop runs in its own goroutine per socket, unpacking replies and
+// matching them back to their pending query by transaction ID.
+func (s *massState) readLoop(conn net.PacketConn) {
+	buf := make([]byte, 1500)
+	for {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		conn.SetReadDeadline(time.Now().Add(massRetryTick))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			continue
+		}
+
+		msg := new(dns.Msg)
+		if err := msg.Unpack(buf[:n]); err != nil {
+			continue
+		}
+
+		s.finishQuery(msg.Id, msg, nil)
+	}
+}
+
+// retransmitUntilDrained retries timed-out queries with exponential
+// backoff (capped at massMaxRetries) until every host's progress has
+// been resolved one way or another.
+func (s *massState) retransmitUntilDrained(ctx context.Context) {
+	ticker := time.NewTicker(massRetryTick)
+	defer ticker.Stop()
+
+	for {
+		if s.remainingHosts() == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		var expired []uint16
+		s.mu.Lock()
+		for id, q := range s.pending {
+			backoff := massRetryBaseDelay << uint(q.tries-1)
+			if now.Sub(q.sentAt) >= backoff {
+				expired = append(expired, id)
+			}
+		}
+		s.mu.Unlock()
+
+		for _, id := range expired {
+			s.retryOrGiveUp(ctx, id)
+		}
+	}
+}
+
+// retryOrGiveUp resends a timed-out query, or gives up and marks it
+// failed once massMaxRetries is reached.
+func (s *massState) retryOrGiveUp(ctx context.Context, id uint16) {
+	s.mu.Lock()
+	q, ok := s.pending[id]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	if q.tries >= massMaxRetries {
+		delete(s.pending, id)
+		s.mu.Unlock()
+		s.recordResult(q.host, q.qtype, nil, fmt.Errorf("mass resolver: %s type %d: no reply after %d tries", q.host, q.qtype, q.tries))
+		return
+	}
+	delete(s.pending, id)
+	host, qtype, upstream := q.host, q.qtype, q.upstream
+	s.mu.Unlock()
+
+	s.limiters[upstream].wait(ctx)
+
+	msg := new(dns.Msg)
+	msg.RecursionDesired = true
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+
+	s.mu.Lock()
+	newID := s.allocID()
+	msg.Id = newID
+	s.pending[newID] = &inFlight{host: host, qtype: qtype, upstream: upstream, sentAt: time.Now(), tries: q.tries + 1}
+	s.mu.Unlock()
+
+	packed, err := msg.Pack()
+	if err != nil {
+		s.finishQuery(newID, nil, err)
+		return
+	}
+	addr, err := net.ResolveUDPAddr("udp", s.upstreams[upstream])
+	if err != nil {
+		s.finishQuery(newID, nil, err)
+		return
+	}
+	conn := s.conns[int(newID)%len(s.conns)]
+	if _, err := conn.WriteTo(packed, addr); err != nil {
+		s.finishQuery(newID, nil, err)
+	}
+}
+
+// finishQuery evicts a pending query (the retransmit wheel's bound on
+// memory) and records its outcome against the owning host's progress.
+func (s *massState) finishQuery(id uint16, msg *dns.Msg, sendErr error) {
+	s.mu.Lock()
+	q, ok := s.pending[id]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.pending, id)
+	s.mu.Unlock()
+
+	if sendErr != nil {
+		s.recordResult(q.host, q.qtype, nil, sendErr)
+		return
+	}
+	if msg.Rcode != dns.RcodeSuccess {
+		s.recordResult(q.host, q.qtype, nil, &net.DNSError{
+			Err:        dns.RcodeToString[msg.Rcode],
+			Name:       q.host,
+			IsNotFound: msg.Rcode == dns.RcodeNameError,
+		})
+		return
+	}
+	s.recordResult(q.host, q.qtype, msg, nil)
+}
+
+// recordResult applies one query's outcome to its host's progress,
+// decrementing the outstanding count so retransmitUntilDrained and
+// collect know when every host is finalized.
+func (s *massState) recordResult(host string, qtype uint16, msg *dns.Msg, lookupErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.progress[host]
+	if !ok {
+		return
+	}
+	p.remaining--
+
+	if msg == nil {
+		if lookupErr != nil {
+			p.lastErr = lookupErr
+		}
+		return
+	}
+
+	for _, rr := range msg.Answer {
+		switch r := rr.(type) {
+		case *dns.CNAME:
+			target := strings.TrimSuffix(strings.ToLower(r.Target), ".")
+			if target != "" && target != host {
+				p.cname = target
+			}
+		case *dns.A:
+			p.ips = append(p.ips, r.A.String())
+		case *dns.AAAA:
+			p.ips = append(p.ips, r.AAAA.String())
+		}
+	}
+}
+
+// remainingHosts counts hosts that still have an outstanding query.
+func (s *massState) remainingHosts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, p := range s.progress {
+		if p.remaining > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// collect turns accumulated per-host progress into the same
+// ([]DNSResult, []DanglingCNAME) shape every other DNSResolver returns.
+func (s *massState) collect(hosts []string) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var records []engine.DNSResult
+	var danglings []engine.DanglingCNAME
+	for _, host := range hosts {
+		p := s.progress[host]
+		if p == nil {
+			continue
+		}
+		if len(p.ips) > 0 {
+			records = append(records, engine.DNSResult{Host: host, IPs: deduplicateStrings(p.ips), CNAME: p.cname})
+			continue
+		}
+		if p.cname != "" {
+			if dangling := checkDangling(host, p.cname, p.lastErr); dangling != nil {
+				danglings = append(danglings, *dangling)
+			}
+		}
+	}
+	return records, danglings, nil
+}
@@ -0,0 +1,60 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func newMassStateForHosts(hosts []string) *massState {
+	progress := make(map[string]*hostProgress, len(hosts))
+	for _, h := range hosts {
+		progress[h] = &hostProgress{remaining: len(massQueryTypes)}
+	}
+	return &massState{
+		upstreams: []string{"127.0.0.1:53"},
+		progress:  progress,
+		pending:   make(map[uint16]*inFlight),
+		done:      make(chan struct{}),
+	}
+}
+
+func TestMassState_RecordResultAccumulatesIPsAndCNAME(t *testing.T) {
+	s := newMassStateForHosts([]string{"foo.example.com"})
+
+	cnameMsg := new(dns.Msg)
+	cnameMsg.Answer = append(cnameMsg.Answer, &dns.CNAME{Target: "bar.herokuapp.com."})
+	s.recordResult("foo.example.com", dns.TypeCNAME, cnameMsg, nil)
+
+	aMsg := new(dns.Msg)
+	aMsg.Answer = append(aMsg.Answer, &dns.A{A: []byte{1, 2, 3, 4}})
+	s.recordResult("foo.example.com", dns.TypeA, aMsg, nil)
+
+	s.recordResult("foo.example.com", dns.TypeAAAA, nil, nil)
+
+	if s.remainingHosts() != 0 {
+		t.Fatalf("remainingHosts = %d, want 0", s.remainingHosts())
+	}
+
+	records, _, err := s.collect([]string{"foo.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("records = %v, want one record", records)
+	}
+	if records[0].CNAME != "bar.herokuapp.com" {
+		t.Errorf("CNAME = %q, want %q", records[0].CNAME, "bar.herokuapp.com")
+	}
+}
+
+func TestMassState_AllocIDNeverReusesAPendingID(t *testing.T) {
+	s := newMassStateForHosts(nil)
+	s.pending[1] = &inFlight{host: "taken"}
+	s.nextID = 0
+
+	id := s.allocID()
+	if id == 1 {
+		t.Errorf("allocID returned an ID already in pending")
+	}
+}
@@ -0,0 +1,186 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/wordlist"
+)
+
+const nsecWalkMaxSteps = 10000
+
+// walkZoneDNSSEC is the AXFR fallback: it first tries to walk the NSEC
+// chain, then falls back to cracking NSEC3 hashes if the NSEC walk yields
+// no hostnames. The NSEC3 opt-out flag is not inspected. Returns a nil
+// slice if the zone isn't DNSSEC-signed.
+func walkZoneDNSSEC(ctx context.Context, domain, nameserver string) ([]string, error) {
+	hostnames, err := walkNSECZone(ctx, domain, nameserver)
+	if err != nil {
+		return nil, err
+	}
+	if hostnames != nil {
+		return hostnames, nil
+	}
+
+	return crackNSEC3Zone(ctx, domain, nameserver)
+}
+
+// walkNSECZone follows the NSEC chain for domain starting at the apex,
+// returning every owner name discovered before the chain wraps back to the
+// apex. It returns a nil slice (no error) if the nameserver isn't signed
+// with NSEC, so callers can fall through to NSEC3 cracking.
+func walkNSECZone(ctx context.Context, domain, nameserver string) ([]string, error) {
+	apex := dns.Fqdn(domain)
+	seen := make(map[string]bool)
+	var hostnames []string
+
+	current := apex
+	for step := 0; step < nsecWalkMaxSteps; step++ {
+		select {
+		case <-ctx.Done():
+			return hostnames, ctx.Err()
+		default:
+		}
+
+		nsec, err := queryNSEC(ctx, current, nameserver)
+		if err != nil {
+			return hostnames, err
+		}
+		if nsec == nil {
+			return nil, nil
+		}
+
+		next := strings.ToLower(nsec.NextDomain)
+		if next != apex {
+			name := strings.TrimSuffix(next, ".")
+			if !seen[name] {
+				seen[name] = true
+				hostnames = append(hostnames, name)
+			}
+		}
+
+		if next == apex || next == current {
+			break
+		}
+		current = next
+	}
+
+	return hostnames, nil
+}
+
+func queryNSEC(ctx context.Context, name, nameserver string) (*dns.NSEC, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(name, dns.TypeNSEC)
+
+	reqCtx, cancel := context.WithTimeout(ctx, axfrDialTimeout)
+	defer cancel()
+
+	client := &dns.Client{}
+	reply, _, err := client.ExchangeContext(reqCtx, msg, net.JoinHostPort(nameserver, "53"))
+	if err != nil {
+		return nil, fmt.Errorf("NSEC query for %s: %w", name, err)
+	}
+
+	for _, rr := range append(append([]dns.RR{}, reply.Answer...), reply.Ns...) {
+		if nsec, ok := rr.(*dns.NSEC); ok {
+			return nsec, nil
+		}
+	}
+	return nil, nil
+}
+
+// nsec3Params holds the salt/iterations/hash-algorithm triple advertised by
+// a zone's NSEC3 records, needed to hash dictionary candidates the same way.
+type nsec3Params struct {
+	hashAlg    uint8
+	iterations uint16
+	salt       string
+}
+
+// crackNSEC3Zone collects NSEC3 hashes observed while probing common labels
+// against nameserver, then attempts an offline dictionary attack against the
+// module's embedded wordlist per RFC 5155 §5. Returns the recovered
+// hostnames, or a nil slice if the zone isn't NSEC3-signed.
+func crackNSEC3Zone(ctx context.Context, domain, nameserver string) ([]string, error) {
+	words := wordlist.Subdomains()
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	hashSet := make(map[string]bool)
+	var params *nsec3Params
+
+	for _, w := range words {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		candidate := fmt.Sprintf("%s.%s", w, domain)
+		records, err := queryNSEC3(ctx, candidate, nameserver)
+		if err != nil {
+			continue
+		}
+		for _, rec := range records {
+			if rec.Hash == 0 && rec.Iterations == 0 && rec.Salt == "" {
+				continue
+			}
+			if params == nil {
+				params = &nsec3Params{hashAlg: rec.Hash, iterations: rec.Iterations, salt: rec.Salt}
+			}
+			hashSet[strings.ToUpper(rec.NextDomain)] = true
+			owner := strings.TrimSuffix(rec.Hdr.Name, ".")
+			if dot := strings.IndexByte(owner, '.'); dot > 0 {
+				hashSet[strings.ToUpper(owner[:dot])] = true
+			}
+		}
+	}
+
+	if params == nil {
+		// Zone isn't NSEC3-signed (or is opt-out with nothing observed).
+		return nil, nil
+	}
+
+	var hostnames []string
+	for _, w := range words {
+		select {
+		case <-ctx.Done():
+			return hostnames, ctx.Err()
+		default:
+		}
+
+		hashed := dns.HashName(dns.Fqdn(fmt.Sprintf("%s.%s", w, domain)), params.hashAlg, params.iterations, params.salt)
+		if hashSet[strings.ToUpper(hashed)] {
+			hostnames = append(hostnames, fmt.Sprintf("%s.%s", w, domain))
+		}
+	}
+
+	return hostnames, nil
+}
+
+func queryNSEC3(ctx context.Context, name, nameserver string) ([]*dns.NSEC3, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), dns.TypeA)
+	msg.SetEdns0(4096, true)
+
+	reqCtx, cancel := context.WithTimeout(ctx, axfrDialTimeout)
+	defer cancel()
+
+	client := &dns.Client{}
+	reply, _, err := client.ExchangeContext(reqCtx, msg, net.JoinHostPort(nameserver, "53"))
+	if err != nil {
+		return nil, fmt.Errorf("NSEC3 query for %s: %w", name, err)
+	}
+
+	var records []*dns.NSEC3
+	for _, rr := range reply.Ns {
+		if n3, ok := rr.(*dns.NSEC3); ok {
+			records = append(records, n3)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,89 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// NSECWalker implements engine.SubdomainEnumerator by walking a
+// DNSSEC-signed zone's NSEC chain, or cracking its NSEC3 hashes, against
+// each authoritative nameserver. A signed zone leaks its full namespace
+// this way even when AXFR is refused, so this runs independently of (and
+// is much less intrusive than) an AXFR attempt. Discovered hosts are
+// tagged with the "nsec-walk" source.
+type NSECWalker struct {
+	mu            sync.Mutex
+	zoneTransfers []engine.ZoneTransfer
+	warnings      []string
+}
+
+// GetZoneTransfers implements engine.ZoneTransferProvider, reporting
+// which nameservers were DNSSEC-walkable.
+func (n *NSECWalker) GetZoneTransfers() []engine.ZoneTransfer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.zoneTransfers
+}
+
+// GetWarnings implements engine.WarningProvider.
+func (n *NSECWalker) GetWarnings() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.warnings
+}
+
+// Enumerate looks up domain's authoritative nameservers and attempts an
+// NSEC/NSEC3 walk against each in turn, honoring context cancellation
+// between nameservers (walkZoneDNSSEC itself checks it between chain
+// steps).
+func (n *NSECWalker) Enumerate(ctx context.Context, domain string, concurrency int) ([]engine.Subdomain, error) {
+	nameservers, err := net.DefaultResolver.LookupNS(ctx, domain)
+	if err != nil {
+		return nil, fmt.Errorf("NS lookup for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var subdomains []engine.Subdomain
+
+	for _, ns := range nameservers {
+		select {
+		case <-ctx.Done():
+			return subdomains, ctx.Err()
+		default:
+		}
+
+		nsHost := strings.TrimSuffix(ns.Host, ".")
+		walked, err := walkZoneDNSSEC(ctx, domain, nsHost)
+		if err != nil {
+			n.mu.Lock()
+			n.warnings = append(n.warnings, fmt.Sprintf("nsec-walk %s: %s", nsHost, err))
+			n.mu.Unlock()
+			continue
+		}
+
+		n.mu.Lock()
+		n.zoneTransfers = append(n.zoneTransfers, engine.ZoneTransfer{
+			Nameserver: nsHost,
+			Success:    len(walked) > 0,
+			Records:    len(walked),
+		})
+		n.mu.Unlock()
+
+		for _, h := range walked {
+			if !seen[h] {
+				seen[h] = true
+				subdomains = append(subdomains, engine.Subdomain{Host: h, Sources: []string{"nsec-walk"}})
+			}
+		}
+	}
+
+	if len(subdomains) == 0 {
+		return nil, fmt.Errorf("no DNSSEC-walkable nameservers for %s", domain)
+	}
+	return subdomains, nil
+}
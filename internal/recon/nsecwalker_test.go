@@ -0,0 +1,28 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func TestNSECWalker_GetZoneTransfersAndWarnings(t *testing.T) {
+	w := &NSECWalker{}
+
+	if got := w.GetZoneTransfers(); got != nil {
+		t.Errorf("expected nil zone transfers before any walk, got %v", got)
+	}
+	if got := w.GetWarnings(); got != nil {
+		t.Errorf("expected nil warnings before any walk, got %v", got)
+	}
+
+	w.zoneTransfers = append(w.zoneTransfers, engine.ZoneTransfer{Nameserver: "ns1.example.com", Success: true, Records: 3})
+	w.warnings = append(w.warnings, "nsec-walk ns2.example.com: timeout")
+
+	if got := w.GetZoneTransfers(); len(got) != 1 || !got[0].Success {
+		t.Errorf("unexpected zone transfers: %+v", got)
+	}
+	if got := w.GetWarnings(); len(got) != 1 {
+		t.Errorf("unexpected warnings: %v", got)
+	}
+}
@@ -10,28 +10,64 @@ import (
 	"github.com/vulnverified/sweep/internal/engine"
 )
 
+type portTarget struct {
+	host string
+	ip   string
+	port int
+}
+
 // PortScan performs TCP connect scanning on the given hosts and ports.
 // Returns only open ports. Closed/filtered ports are silently skipped.
 func PortScan(ctx context.Context, dnsRecords []engine.DNSResult, ports []int, concurrency int, timeout time.Duration) ([]engine.PortResult, error) {
-	type target struct {
-		host string
-		ip   string
-		port int
+	return portScan(ctx, buildPortTargets(dnsRecords, ports), concurrency, timeout)
+}
+
+// PortScanResume behaves like PortScan but skips (host, port) pairs
+// already present in prior, returning prior merged with any newly found
+// open ports. It's used to resume a checkpointed run without re-dialing
+// pairs a previous, interrupted scan already confirmed open.
+func PortScanResume(ctx context.Context, dnsRecords []engine.DNSResult, ports []int, concurrency int, timeout time.Duration, prior []engine.PortResult) ([]engine.PortResult, error) {
+	known := make(map[portTarget]bool, len(prior))
+	for _, p := range prior {
+		known[portTarget{host: p.Host, ip: p.IP, port: p.Port}] = true
+	}
+
+	var remaining []portTarget
+	for _, t := range buildPortTargets(dnsRecords, ports) {
+		if !known[t] {
+			remaining = append(remaining, t)
+		}
 	}
 
-	// Build work items: host x port combinations.
-	var targets []target
+	found, err := portScan(ctx, remaining, concurrency, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]engine.PortResult, 0, len(prior)+len(found))
+	results = append(results, prior...)
+	results = append(results, found...)
+	return results, nil
+}
+
+// buildPortTargets expands dnsRecords x ports into concrete (host, ip,
+// port) work items, using the first resolved IP per host.
+func buildPortTargets(dnsRecords []engine.DNSResult, ports []int) []portTarget {
+	var targets []portTarget
 	for _, r := range dnsRecords {
 		if len(r.IPs) == 0 {
 			continue
 		}
 		ip := r.IPs[0]
 		for _, port := range ports {
-			targets = append(targets, target{host: r.Host, ip: ip, port: port})
+			targets = append(targets, portTarget{host: r.Host, ip: ip, port: port})
 		}
 	}
+	return targets
+}
 
-	work := make(chan target, len(targets))
+func portScan(ctx context.Context, targets []portTarget, concurrency int, timeout time.Duration) ([]engine.PortResult, error) {
+	work := make(chan portTarget, len(targets))
 	for _, t := range targets {
 		work <- t
 	}
@@ -0,0 +1,70 @@
+package recon
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProviderKeys holds API keys for the authenticated passive sources.
+// Matching subfinder's provider-config shape, the YAML keys are the
+// lowercase source names.
+type ProviderKeys struct {
+	Chaos          string `yaml:"chaos"`
+	SecurityTrails string `yaml:"securitytrails"`
+	VirusTotal     string `yaml:"virustotal"`
+	Shodan         string `yaml:"shodan"`
+	BinaryEdge     string `yaml:"binaryedge"`
+	Censys         string `yaml:"censys"`
+	// CensysSecret is the Censys API's matching secret; Censys
+	// authenticates with an (ID, secret) pair rather than a single key.
+	CensysSecret string `yaml:"censys_secret"`
+	GitHub       string `yaml:"github"`
+}
+
+// DefaultProvidersConfigPath returns ~/.config/sweep/providers.yaml, the
+// conventional location for provider API keys, or "" if the user's home
+// directory can't be determined.
+func DefaultProvidersConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "sweep", "providers.yaml")
+}
+
+// LoadProviderKeys reads API keys from the YAML file at path (if it
+// exists; a missing file is not an error, since every source is optional),
+// then applies SWEEP_<SOURCE>_KEY environment variable overrides.
+func LoadProviderKeys(path string) (ProviderKeys, error) {
+	var keys ProviderKeys
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			if err := yaml.Unmarshal(data, &keys); err != nil {
+				return keys, err
+			}
+		} else if !os.IsNotExist(err) {
+			return keys, err
+		}
+	}
+
+	applyEnvOverride(&keys.Chaos, "SWEEP_CHAOS_KEY")
+	applyEnvOverride(&keys.SecurityTrails, "SWEEP_SECURITYTRAILS_KEY")
+	applyEnvOverride(&keys.VirusTotal, "SWEEP_VIRUSTOTAL_KEY")
+	applyEnvOverride(&keys.Shodan, "SWEEP_SHODAN_KEY")
+	applyEnvOverride(&keys.BinaryEdge, "SWEEP_BINARYEDGE_KEY")
+	applyEnvOverride(&keys.Censys, "SWEEP_CENSYS_KEY")
+	applyEnvOverride(&keys.CensysSecret, "SWEEP_CENSYS_SECRET")
+	applyEnvOverride(&keys.GitHub, "SWEEP_GITHUB_KEY")
+
+	return keys, nil
+}
+
+func applyEnvOverride(dst *string, envVar string) {
+	if v := os.Getenv(envVar); v != "" {
+		*dst = v
+	}
+}
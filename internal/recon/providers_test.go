@@ -0,0 +1,47 @@
+package recon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProviderKeysFromYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "providers.yaml")
+	yaml := "chaos: chaos-key\nsecuritytrails: st-key\nvirustotal: vt-key\nshodan: shodan-key\nbinaryedge: be-key\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadProviderKeys(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys.Chaos != "chaos-key" || keys.SecurityTrails != "st-key" || keys.VirusTotal != "vt-key" ||
+		keys.Shodan != "shodan-key" || keys.BinaryEdge != "be-key" {
+		t.Errorf("unexpected keys: %+v", keys)
+	}
+}
+
+func TestLoadProviderKeysMissingFileIsNotError(t *testing.T) {
+	keys, err := LoadProviderKeys(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("missing file should not error: %v", err)
+	}
+	if keys.Chaos != "" {
+		t.Errorf("expected empty keys, got %+v", keys)
+	}
+}
+
+func TestLoadProviderKeysEnvOverride(t *testing.T) {
+	t.Setenv("SWEEP_CHAOS_KEY", "env-chaos-key")
+
+	keys, err := LoadProviderKeys("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys.Chaos != "env-chaos-key" {
+		t.Errorf("expected env override to apply, got %q", keys.Chaos)
+	}
+}
@@ -0,0 +1,61 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	rapiddnsBaseURL = "https://rapiddns.io/subdomain/%s?full=1"
+	rapiddnsTimeout = 20 * time.Second
+	rapiddnsMaxBody = 20 * 1024 * 1024 // 20MB
+)
+
+// rapiddnsRowRegex extracts the contents of every hostname-shaped <td> cell
+// in the results table; RapidDNS has no JSON API, only an HTML table.
+var rapiddnsRowRegex = regexp.MustCompile(`(?i)<td>([a-z0-9*_.-]+\.[a-z]{2,})</td>`)
+
+// RapidDNSEnumerate scrapes RapidDNS's subdomain search results page. No
+// API key required.
+func RapidDNSEnumerate(ctx context.Context, domain string) ([]string, error) {
+	url := fmt.Sprintf(rapiddnsBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, nil, rapiddnsTimeout, rapiddnsMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("rapiddns fetch for %s: %w", domain, err)
+	}
+
+	return parseRapidDNSTable(body, domain), nil
+}
+
+// parseRapidDNSTable extracts in-scope hostnames from RapidDNS's HTML
+// results table.
+func parseRapidDNSTable(body []byte, domain string) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, match := range rapiddnsRowRegex.FindAllStringSubmatch(string(body), -1) {
+		host := strings.ToLower(strings.TrimSuffix(match[1], "."))
+		if host == "" || strings.HasPrefix(host, "*.") {
+			continue
+		}
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// rapiddnsSource adapts RapidDNSEnumerate to PassiveSource.
+type rapiddnsSource struct{}
+
+func (s *rapiddnsSource) Name() string  { return "rapiddns" }
+func (s *rapiddnsSource) Enabled() bool { return true }
+func (s *rapiddnsSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return RapidDNSEnumerate(ctx, domain)
+}
@@ -0,0 +1,12 @@
+package recon
+
+import "testing"
+
+func TestParseRapidDNSTable(t *testing.T) {
+	body := []byte(`<table><tr><td>www.example.com</td><td>A</td></tr><tr><td>*.example.com</td></tr><tr><td>other.notexample.com</td></tr></table>`)
+
+	hosts := parseRapidDNSTable(body, "example.com")
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
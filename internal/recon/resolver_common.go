@@ -0,0 +1,65 @@
+package recon
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// hostResolver resolves a single host's A/AAAA/CNAME records against one
+// upstream. DoHResolver, DoTResolver, UDPResolver, Resolver, and
+// MultiResolver all implement it so their Resolve methods can share the
+// same worker-pool fan-out via resolveHostsConcurrently.
+type hostResolver interface {
+	resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool)
+}
+
+// resolveHostsConcurrently fans hosts out across concurrency workers,
+// calling resolve for each and collecting resolved records and detected
+// dangling CNAMEs.
+func resolveHostsConcurrently(ctx context.Context, hosts []string, concurrency int, resolve func(context.Context, string) (engine.DNSResult, *engine.DanglingCNAME, bool)) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	work := make(chan string, len(hosts))
+	for _, h := range hosts {
+		work <- h
+	}
+	close(work)
+
+	var (
+		mu        sync.Mutex
+		results   []engine.DNSResult
+		danglings []engine.DanglingCNAME
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for host := range work {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				record, dangling, ok := resolve(ctx, host)
+				if dangling != nil {
+					mu.Lock()
+					danglings = append(danglings, *dangling)
+					mu.Unlock()
+					continue
+				}
+				if !ok {
+					continue
+				}
+				mu.Lock()
+				results = append(results, record)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return results, danglings, nil
+}
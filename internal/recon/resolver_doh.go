@@ -0,0 +1,186 @@
+package recon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const (
+	dohContentType    = "application/dns-message"
+	dohRequestTimeout = 10 * time.Second
+	dohMaxBody        = 64 * 1024
+)
+
+// DoHResolver implements engine.DNSResolver over DNS-over-HTTPS (RFC 8484),
+// POSTing wire-format queries to a single upstream endpoint such as
+// https://1.1.1.1/dns-query. It reuses one http.Client (HTTP/2-capable)
+// across all queries.
+//
+// When Endpoint's host is a hostname rather than an IP literal (e.g.
+// https://dns.google/dns-query), the client can't reach it without
+// already having working DNS. Bootstrap, if set, is used to resolve that
+// hostname once and the result is pinned for the lifetime of the
+// resolver so every subsequent DoH request dials the IP directly.
+type DoHResolver struct {
+	Endpoint  string
+	Bootstrap hostResolver
+
+	client        *http.Client
+	bootstrapOnce sync.Once
+	bootstrapIP   string
+	bootstrapErr  error
+}
+
+// NewDoHResolver returns a DoHResolver for the given endpoint. bootstrap
+// may be nil, in which case a hostname endpoint is resolved via the
+// platform stub resolver.
+func NewDoHResolver(endpoint string, bootstrap hostResolver) *DoHResolver {
+	d := &DoHResolver{Endpoint: endpoint, Bootstrap: bootstrap}
+	d.client = &http.Client{
+		Timeout: dohRequestTimeout,
+		Transport: &http.Transport{
+			DialContext:       d.dialBootstrapped,
+			ForceAttemptHTTP2: true,
+		},
+	}
+	return d
+}
+
+// dialBootstrapped dials addr as usual unless Endpoint's host needs
+// bootstrapping, in which case it substitutes the pinned bootstrap IP
+// while leaving TLS SNI (derived from the request URL, not the dialed
+// address) untouched.
+func (d *DoHResolver) dialBootstrapped(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		host, port = addr, "443"
+	}
+	if net.ParseIP(host) != nil {
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+
+	d.bootstrapOnce.Do(func() {
+		resolver := d.Bootstrap
+		if resolver == nil {
+			resolver = &Resolver{}
+		}
+		record, _, ok := resolver.resolveHost(ctx, host)
+		if !ok || len(record.IPs) == 0 {
+			d.bootstrapErr = fmt.Errorf("bootstrap resolve %s: no A/AAAA records", host)
+			return
+		}
+		d.bootstrapIP = record.IPs[0]
+	})
+	if d.bootstrapErr != nil {
+		return nil, d.bootstrapErr
+	}
+
+	return (&net.Dialer{}).DialContext(ctx, network, net.JoinHostPort(d.bootstrapIP, port))
+}
+
+// Resolve implements engine.DNSResolver, fanning DoH queries out across
+// concurrency workers.
+func (d *DoHResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	return resolveHostsConcurrently(ctx, hosts, concurrency, d.resolveHost)
+}
+
+func (d *DoHResolver) resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	record := engine.DNSResult{Host: host}
+
+	if resp, err := d.query(ctx, host, dns.TypeCNAME); err == nil {
+		for _, rr := range resp.Answer {
+			if c, ok := rr.(*dns.CNAME); ok {
+				target := strings.TrimSuffix(strings.ToLower(c.Target), ".")
+				if target != host && target != "" {
+					record.CNAME = target
+				}
+			}
+		}
+	}
+
+	var ips []string
+	var lookupErr error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		resp, err := d.query(ctx, host, qtype)
+		if err != nil {
+			lookupErr = err
+			continue
+		}
+		for _, rr := range resp.Answer {
+			switch r := rr.(type) {
+			case *dns.A:
+				ips = append(ips, r.A.String())
+			case *dns.AAAA:
+				ips = append(ips, r.AAAA.String())
+			}
+		}
+	}
+
+	if len(ips) == 0 {
+		if record.CNAME != "" {
+			return engine.DNSResult{}, checkDangling(host, record.CNAME, lookupErr), false
+		}
+		return engine.DNSResult{}, nil, false
+	}
+
+	record.IPs = deduplicateStrings(ips)
+	return record, nil, true
+}
+
+// query issues a single DoH wire-format request and returns the parsed response.
+func (d *DoHResolver) query(ctx context.Context, host string, qtype uint16) (*dns.Msg, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+	msg.RecursionDesired = true
+
+	packed, err := msg.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("pack DNS query for %s: %w", host, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Endpoint, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("DoH request to %s: %w", d.Endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DoH %s returned status %d", d.Endpoint, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dohMaxBody))
+	if err != nil {
+		return nil, fmt.Errorf("read DoH response from %s: %w", d.Endpoint, err)
+	}
+
+	reply := new(dns.Msg)
+	if err := reply.Unpack(body); err != nil {
+		return nil, fmt.Errorf("unpack DoH response from %s: %w", d.Endpoint, err)
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return nil, &net.DNSError{
+			Err:        dns.RcodeToString[reply.Rcode],
+			Name:       host,
+			IsNotFound: reply.Rcode == dns.RcodeNameError,
+		}
+	}
+
+	return reply, nil
+}
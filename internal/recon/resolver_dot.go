@@ -0,0 +1,133 @@
+package recon
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const dotConnTimeout = 5 * time.Second
+
+// DoTResolver implements engine.DNSResolver over DNS-over-TLS (RFC 7858)
+// against a single upstream such as 8.8.8.8:853. It pools persistent
+// TCP+TLS connections rather than dialing one per query.
+type DoTResolver struct {
+	Addr string
+
+	mu   sync.Mutex
+	pool []*dns.Conn
+}
+
+// NewDoTResolver returns a DoTResolver for the given host:port address.
+func NewDoTResolver(addr string) *DoTResolver {
+	return &DoTResolver{Addr: addr}
+}
+
+func (d *DoTResolver) getConn() (*dns.Conn, error) {
+	d.mu.Lock()
+	if n := len(d.pool); n > 0 {
+		conn := d.pool[n-1]
+		d.pool = d.pool[:n-1]
+		d.mu.Unlock()
+		return conn, nil
+	}
+	d.mu.Unlock()
+
+	dialer := &net.Dialer{Timeout: dotConnTimeout}
+	tlsConn, err := tls.DialWithDialer(dialer, "tcp", d.Addr, &tls.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("DoT dial %s: %w", d.Addr, err)
+	}
+	return &dns.Conn{Conn: tlsConn}, nil
+}
+
+func (d *DoTResolver) putConn(conn *dns.Conn) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pool = append(d.pool, conn)
+}
+
+func (d *DoTResolver) query(ctx context.Context, host string, qtype uint16) (*dns.Msg, error) {
+	conn, err := d.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(dotConnTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+	msg.RecursionDesired = true
+
+	if err := conn.WriteMsg(msg); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("DoT write to %s: %w", d.Addr, err)
+	}
+	reply, err := conn.ReadMsg()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("DoT read from %s: %w", d.Addr, err)
+	}
+
+	d.putConn(conn)
+	return reply, nil
+}
+
+// Resolve implements engine.DNSResolver using pooled DoT connections.
+func (d *DoTResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	return resolveHostsConcurrently(ctx, hosts, concurrency, d.resolveHost)
+}
+
+func (d *DoTResolver) resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	record := engine.DNSResult{Host: host}
+
+	if resp, err := d.query(ctx, host, dns.TypeCNAME); err == nil {
+		for _, rr := range resp.Answer {
+			if c, ok := rr.(*dns.CNAME); ok {
+				target := strings.TrimSuffix(strings.ToLower(c.Target), ".")
+				if target != host && target != "" {
+					record.CNAME = target
+				}
+			}
+		}
+	}
+
+	var ips []string
+	var lookupErr error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		resp, err := d.query(ctx, host, qtype)
+		if err != nil {
+			lookupErr = err
+			continue
+		}
+		for _, rr := range resp.Answer {
+			switch r := rr.(type) {
+			case *dns.A:
+				ips = append(ips, r.A.String())
+			case *dns.AAAA:
+				ips = append(ips, r.AAAA.String())
+			}
+		}
+	}
+
+	if len(ips) == 0 {
+		if record.CNAME != "" {
+			return engine.DNSResult{}, checkDangling(host, record.CNAME, lookupErr), false
+		}
+		return engine.DNSResult{}, nil, false
+	}
+
+	record.IPs = deduplicateStrings(ips)
+	return record, nil, true
+}
@@ -0,0 +1,64 @@
+package recon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// fakeResolver always fails, or always succeeds, tagging the result with
+// its own name so tests can see which upstream answered.
+type fakeResolver struct {
+	name string
+	ok   bool
+}
+
+func (f *fakeResolver) resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	if !f.ok {
+		return engine.DNSResult{}, nil, false
+	}
+	return engine.DNSResult{Host: host, IPs: []string{"1.2.3.4"}}, nil, true
+}
+
+func TestMultiResolver_FallsThroughToNextUpstreamOnFailure(t *testing.T) {
+	m := &MultiResolver{upstreams: []namedHostResolver{
+		{name: "bad", resolver: &fakeResolver{name: "bad", ok: false}},
+		{name: "good", resolver: &fakeResolver{name: "good", ok: true}},
+	}}
+
+	record, dangling, ok := m.resolveHost(context.Background(), "example.com")
+	if !ok {
+		t.Fatal("expected resolution to succeed via the second upstream")
+	}
+	if dangling != nil {
+		t.Errorf("unexpected dangling result: %v", dangling)
+	}
+	if record.Resolver != "good" {
+		t.Errorf("resolver = %q, want %q", record.Resolver, "good")
+	}
+}
+
+func TestMultiResolver_AllUpstreamsFail(t *testing.T) {
+	m := &MultiResolver{upstreams: []namedHostResolver{
+		{name: "bad1", resolver: &fakeResolver{name: "bad1", ok: false}},
+		{name: "bad2", resolver: &fakeResolver{name: "bad2", ok: false}},
+	}}
+
+	if _, _, ok := m.resolveHost(context.Background(), "example.com"); ok {
+		t.Fatal("expected resolution to fail when every upstream fails")
+	}
+}
+
+func TestMultiResolver_RotatesStartingUpstream(t *testing.T) {
+	m := &MultiResolver{upstreams: []namedHostResolver{
+		{name: "a", resolver: &fakeResolver{name: "a", ok: true}},
+		{name: "b", resolver: &fakeResolver{name: "b", ok: true}},
+	}}
+
+	first := m.rotation()
+	second := m.rotation()
+	if first[0].name == second[0].name {
+		t.Errorf("expected rotation to advance the starting upstream between calls, got %q both times", first[0].name)
+	}
+}
@@ -0,0 +1,103 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const udpQueryTimeout = 5 * time.Second
+
+// UDPResolver implements engine.DNSResolver against a single upstream
+// nameserver over plain UDP (e.g. "9.9.9.9:53"), using
+// github.com/miekg/dns's dns.Client. A truncated UDP response is retried
+// over TCP against the same nameserver.
+type UDPResolver struct {
+	Addr   string
+	client *dns.Client
+}
+
+// NewUDPResolver returns a UDPResolver for the given host:port address.
+func NewUDPResolver(addr string) *UDPResolver {
+	return &UDPResolver{Addr: addr, client: &dns.Client{Timeout: udpQueryTimeout}}
+}
+
+func (u *UDPResolver) query(ctx context.Context, host string, qtype uint16) (*dns.Msg, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(host), qtype)
+	msg.RecursionDesired = true
+
+	reply, _, err := u.client.ExchangeContext(ctx, msg, u.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("udp query %s via %s: %w", host, u.Addr, err)
+	}
+	if reply.Truncated {
+		tcpClient := &dns.Client{Net: "tcp", Timeout: udpQueryTimeout}
+		reply, _, err = tcpClient.ExchangeContext(ctx, msg, u.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("tcp query %s via %s: %w", host, u.Addr, err)
+		}
+	}
+	if reply.Rcode != dns.RcodeSuccess {
+		return nil, &net.DNSError{
+			Err:        dns.RcodeToString[reply.Rcode],
+			Name:       host,
+			IsNotFound: reply.Rcode == dns.RcodeNameError,
+		}
+	}
+	return reply, nil
+}
+
+func (u *UDPResolver) resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	record := engine.DNSResult{Host: host}
+
+	if resp, err := u.query(ctx, host, dns.TypeCNAME); err == nil {
+		for _, rr := range resp.Answer {
+			if c, ok := rr.(*dns.CNAME); ok {
+				target := strings.TrimSuffix(strings.ToLower(c.Target), ".")
+				if target != host && target != "" {
+					record.CNAME = target
+				}
+			}
+		}
+	}
+
+	var ips []string
+	var lookupErr error
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		resp, err := u.query(ctx, host, qtype)
+		if err != nil {
+			lookupErr = err
+			continue
+		}
+		for _, rr := range resp.Answer {
+			switch r := rr.(type) {
+			case *dns.A:
+				ips = append(ips, r.A.String())
+			case *dns.AAAA:
+				ips = append(ips, r.AAAA.String())
+			}
+		}
+	}
+
+	if len(ips) == 0 {
+		if record.CNAME != "" {
+			return engine.DNSResult{}, checkDangling(host, record.CNAME, lookupErr), false
+		}
+		return engine.DNSResult{}, nil, false
+	}
+
+	record.IPs = deduplicateStrings(ips)
+	return record, nil, true
+}
+
+// Resolve implements engine.DNSResolver, fanning queries out across
+// concurrency workers.
+func (u *UDPResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	return resolveHostsConcurrently(ctx, hosts, concurrency, u.resolveHost)
+}
@@ -0,0 +1,154 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// MultiResolver round-robins DNS lookups across several upstream
+// resolvers (DoH, DoT, or plain UDP). If an upstream fails to resolve a
+// host (e.g. SERVFAIL or timeout), the next upstream in the list is
+// tried before giving up. Each resolved DNSResult records which upstream
+// answered, via its Resolver field, for auditability.
+type MultiResolver struct {
+	upstreams []namedHostResolver
+
+	mu       sync.Mutex
+	pos      int
+	findings []engine.DNSFinding
+}
+
+type namedHostResolver struct {
+	name     string
+	resolver hostResolver
+}
+
+// NewUpstreamResolver builds an engine.DNSResolver that round-robins
+// across the given upstream URIs. Supported schemes are "https" (DoH,
+// RFC 8484), "tls" (DoT, RFC 7858), and "udp" (plain DNS). An empty list
+// falls back to the platform stub resolver.
+func NewUpstreamResolver(upstreams []string) (engine.DNSResolver, error) {
+	if len(upstreams) == 0 {
+		return &MultiResolver{upstreams: []namedHostResolver{{name: "system", resolver: &Resolver{}}}}, nil
+	}
+
+	bootstrap := bootstrapResolver(upstreams)
+
+	m := &MultiResolver{}
+	for _, upstream := range upstreams {
+		r, err := newHostResolver(upstream, bootstrap)
+		if err != nil {
+			return nil, err
+		}
+		m.upstreams = append(m.upstreams, namedHostResolver{name: upstream, resolver: r})
+	}
+	return m, nil
+}
+
+// bootstrapResolver picks a plain-DNS upstream from the configured list to
+// resolve DoH endpoints that are given as hostnames rather than IP
+// literals (e.g. https://dns.google/dns-query), so DoH itself never needs
+// to recursively depend on already-working DNS. Falls back to the
+// platform stub resolver when no plain upstream is configured.
+func bootstrapResolver(upstreams []string) hostResolver {
+	for _, upstream := range upstreams {
+		u, err := url.Parse(upstream)
+		if err != nil {
+			continue
+		}
+		if u.Scheme != "udp" {
+			continue
+		}
+		addr := u.Host
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, "53")
+		}
+		return NewUDPResolver(addr)
+	}
+	return &Resolver{}
+}
+
+func newHostResolver(upstream string, bootstrap hostResolver) (hostResolver, error) {
+	u, err := url.Parse(upstream)
+	if err != nil {
+		return nil, fmt.Errorf("parse resolver upstream %q: %w", upstream, err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		return NewDoHResolver(upstream, bootstrap), nil
+	case "tls":
+		addr := u.Host
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, "853")
+		}
+		return NewDoTResolver(addr), nil
+	case "udp":
+		addr := u.Host
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, "53")
+		}
+		return NewUDPResolver(addr), nil
+	default:
+		return nil, fmt.Errorf("unsupported resolver scheme %q (want https://, tls://, or udp://)", u.Scheme)
+	}
+}
+
+// rotation returns the configured upstreams in round-robin order,
+// starting from a different offset each call so load spreads evenly
+// across hosts instead of always preferring the first upstream.
+func (m *MultiResolver) rotation() []namedHostResolver {
+	m.mu.Lock()
+	start := m.pos
+	m.pos = (m.pos + 1) % len(m.upstreams)
+	m.mu.Unlock()
+
+	ordered := make([]namedHostResolver, len(m.upstreams))
+	for i := range m.upstreams {
+		ordered[i] = m.upstreams[(start+i)%len(m.upstreams)]
+	}
+	return ordered
+}
+
+// resolveHost tries each upstream in round-robin order, falling through
+// to the next on failure, and tags the winning record with the upstream
+// that answered it.
+func (m *MultiResolver) resolveHost(ctx context.Context, host string) (engine.DNSResult, *engine.DanglingCNAME, bool) {
+	var lastDangling *engine.DanglingCNAME
+
+	for _, up := range m.rotation() {
+		record, dangling, ok := up.resolver.resolveHost(ctx, host)
+		if ok {
+			record.Resolver = up.name
+			return record, nil, true
+		}
+		if dangling != nil {
+			lastDangling = dangling
+		}
+	}
+	return engine.DNSResult{}, lastDangling, false
+}
+
+// Resolve implements engine.DNSResolver.
+func (m *MultiResolver) Resolve(ctx context.Context, domain string, hosts []string, concurrency int) ([]engine.DNSResult, []engine.DanglingCNAME, error) {
+	records, dangling, err := resolveHostsConcurrently(ctx, hosts, concurrency, m.resolveHost)
+	if err == nil {
+		findings := AnalyzeDNSFindings(ctx, domain, records)
+		m.mu.Lock()
+		m.findings = findings
+		m.mu.Unlock()
+	}
+	return records, dangling, err
+}
+
+// GetDNSFindings implements engine.DNSFindingsProvider.
+func (m *MultiResolver) GetDNSFindings() []engine.DNSFinding {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.findings
+}
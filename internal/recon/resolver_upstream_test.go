@@ -0,0 +1,98 @@
+package recon
+
+import "testing"
+
+func TestNewHostResolver(t *testing.T) {
+	tests := []struct {
+		name     string
+		upstream string
+		wantType string
+		wantErr  bool
+	}{
+		{name: "doh endpoint", upstream: "https://1.1.1.1/dns-query", wantType: "*recon.DoHResolver"},
+		{name: "dot endpoint with port", upstream: "tls://8.8.8.8:853", wantType: "*recon.DoTResolver"},
+		{name: "dot endpoint without port defaults to 853", upstream: "tls://8.8.8.8", wantType: "*recon.DoTResolver"},
+		{name: "udp endpoint with port", upstream: "udp://9.9.9.9:53", wantType: "*recon.UDPResolver"},
+		{name: "udp endpoint without port defaults to 53", upstream: "udp://9.9.9.9", wantType: "*recon.UDPResolver"},
+		{name: "unsupported scheme", upstream: "ftp://9.9.9.9", wantErr: true},
+		{name: "invalid uri", upstream: "://bad", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := newHostResolver(tt.upstream, &Resolver{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			switch tt.wantType {
+			case "*recon.DoHResolver":
+				if _, ok := resolver.(*DoHResolver); !ok {
+					t.Errorf("got %T, want *DoHResolver", resolver)
+				}
+			case "*recon.DoTResolver":
+				dot, ok := resolver.(*DoTResolver)
+				if !ok {
+					t.Errorf("got %T, want *DoTResolver", resolver)
+					return
+				}
+				if dot.Addr != "8.8.8.8:853" {
+					t.Errorf("addr = %q, want %q", dot.Addr, "8.8.8.8:853")
+				}
+			case "*recon.UDPResolver":
+				udp, ok := resolver.(*UDPResolver)
+				if !ok {
+					t.Errorf("got %T, want *UDPResolver", resolver)
+					return
+				}
+				if udp.Addr != "9.9.9.9:53" {
+					t.Errorf("addr = %q, want %q", udp.Addr, "9.9.9.9:53")
+				}
+			}
+		})
+	}
+}
+
+func TestNewUpstreamResolver_EmptyFallsBackToSystem(t *testing.T) {
+	resolver, err := NewUpstreamResolver(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	multi, ok := resolver.(*MultiResolver)
+	if !ok {
+		t.Fatalf("got %T, want *MultiResolver", resolver)
+	}
+	if len(multi.upstreams) != 1 || multi.upstreams[0].name != "system" {
+		t.Errorf("upstreams = %v, want a single system resolver", multi.upstreams)
+	}
+}
+
+func TestNewUpstreamResolver_PropagatesInvalidUpstream(t *testing.T) {
+	if _, err := NewUpstreamResolver([]string{"ftp://nope"}); err == nil {
+		t.Fatal("expected error for unsupported scheme")
+	}
+}
+
+func TestBootstrapResolver_PrefersConfiguredUDPUpstream(t *testing.T) {
+	resolver := bootstrapResolver([]string{"https://dns.google/dns-query", "udp://9.9.9.9:53"})
+	udp, ok := resolver.(*UDPResolver)
+	if !ok {
+		t.Fatalf("got %T, want *UDPResolver", resolver)
+	}
+	if udp.Addr != "9.9.9.9:53" {
+		t.Errorf("addr = %q, want %q", udp.Addr, "9.9.9.9:53")
+	}
+}
+
+func TestBootstrapResolver_FallsBackToSystemWithoutUDPUpstream(t *testing.T) {
+	resolver := bootstrapResolver([]string{"https://dns.google/dns-query"})
+	if _, ok := resolver.(*Resolver); !ok {
+		t.Errorf("got %T, want *Resolver (system)", resolver)
+	}
+}
@@ -0,0 +1,69 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	securityTrailsBaseURL = "https://api.securitytrails.com/v1/domain/%s/subdomains"
+	securityTrailsTimeout = 15 * time.Second
+	securityTrailsMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type securityTrailsResponse struct {
+	Subdomains []string `json:"subdomains"`
+}
+
+// SecurityTrailsEnumerate queries the SecurityTrails subdomains API.
+// Requires an API key; returns an error if apiKey is empty.
+func SecurityTrailsEnumerate(ctx context.Context, domain, apiKey string) ([]string, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("securitytrails: no API key configured")
+	}
+
+	url := fmt.Sprintf(securityTrailsBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, map[string]string{"apikey": apiKey}, securityTrailsTimeout, securityTrailsMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("securitytrails fetch for %s: %w", domain, err)
+	}
+
+	return parseSecurityTrailsResponse(body, domain)
+}
+
+// parseSecurityTrailsResponse extracts hostnames from a SecurityTrails
+// subdomains response. SecurityTrails returns bare labels (e.g. "www"),
+// not FQDNs.
+func parseSecurityTrailsResponse(body []byte, domain string) ([]string, error) {
+	var resp securityTrailsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("securitytrails JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, label := range resp.Subdomains {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" {
+			continue
+		}
+		host := label + "." + domain
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// securityTrailsSource adapts SecurityTrailsEnumerate to PassiveSource.
+type securityTrailsSource struct{ APIKey string }
+
+func (s *securityTrailsSource) Name() string  { return "securitytrails" }
+func (s *securityTrailsSource) Enabled() bool { return s.APIKey != "" }
+func (s *securityTrailsSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return SecurityTrailsEnumerate(ctx, domain, s.APIKey)
+}
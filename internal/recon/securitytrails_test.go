@@ -0,0 +1,28 @@
+package recon
+
+import "testing"
+
+func TestSecurityTrailsEnumerateRequiresAPIKey(t *testing.T) {
+	if _, err := SecurityTrailsEnumerate(nil, "example.com", ""); err == nil {
+		t.Fatal("expected error with no API key")
+	}
+}
+
+func TestParseSecurityTrailsResponseQualifiesLabels(t *testing.T) {
+	body := []byte(`{"subdomains":["www","api"]}`)
+
+	hosts, err := parseSecurityTrailsResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(expected) {
+		t.Fatalf("got %d hosts, want %d: %v", len(hosts), len(expected), hosts)
+	}
+	for _, h := range hosts {
+		if !expected[h] {
+			t.Errorf("unexpected host %q, want labels qualified with example.com", h)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	shodanBaseURL = "https://api.shodan.io/dns/domain/%s?key=%s"
+	shodanTimeout = 15 * time.Second
+	shodanMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type shodanResponse struct {
+	Subdomains []string `json:"subdomains"`
+}
+
+// ShodanEnumerate queries Shodan's DNS domain API for subdomains.
+// Requires an API key; returns an error if apiKey is empty.
+func ShodanEnumerate(ctx context.Context, domain, apiKey string) ([]string, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("shodan: no API key configured")
+	}
+
+	url := fmt.Sprintf(shodanBaseURL, domain, apiKey)
+	body, err := sourceHTTPGet(ctx, url, nil, shodanTimeout, shodanMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("shodan fetch for %s: %w", domain, err)
+	}
+
+	return parseShodanResponse(body, domain)
+}
+
+// parseShodanResponse extracts hostnames from a Shodan DNS domain response.
+// Shodan returns bare labels (e.g. "www"), not FQDNs.
+func parseShodanResponse(body []byte, domain string) ([]string, error) {
+	var resp shodanResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("shodan JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, label := range resp.Subdomains {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" {
+			continue
+		}
+		host := label + "." + domain
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// shodanSource adapts ShodanEnumerate to PassiveSource.
+type shodanSource struct{ APIKey string }
+
+func (s *shodanSource) Name() string  { return "shodan" }
+func (s *shodanSource) Enabled() bool { return s.APIKey != "" }
+func (s *shodanSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return ShodanEnumerate(ctx, domain, s.APIKey)
+}
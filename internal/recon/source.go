@@ -0,0 +1,92 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PassiveSource is a passive subdomain-discovery provider: it queries an
+// external index (CT logs, passive DNS, a search engine, brute force
+// against the live resolver, etc.) and returns the hostnames it found.
+// Sources that need an API key self-disable via Enabled() rather than
+// warning, so running without optional credentials configured is quiet.
+type PassiveSource interface {
+	Name() string
+	Enabled() bool
+	Enumerate(ctx context.Context, domain string) ([]string, error)
+}
+
+// defaultRateLimitRetryAfter is the backoff applied when a source returns
+// 429 without a usable Retry-After header.
+const defaultRateLimitRetryAfter = 10 * time.Second
+
+// RateLimitError is returned by a PassiveSource (or sourceHTTPGet, on its
+// behalf) when the upstream API signals it is being rate limited. A
+// SourceRegistry uses RetryAfter to back off that source specifically
+// instead of aborting the whole enumeration.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", e.RetryAfter)
+}
+
+// sourceHTTPGet issues a GET request and returns the response body, capped
+// at maxBody bytes. A 429 response yields a *RateLimitError (honoring a
+// Retry-After header, in seconds, when present) so the caller's rate
+// limiter can back off; any other non-200 status is a generic error. This
+// is shared by the PassiveSource adapters that don't need any response
+// handling beyond "fetch and parse"; crt.sh/OTX/HackerTarget predate this
+// helper and keep their own copies.
+func sourceHTTPGet(ctx context.Context, url string, headers map[string]string, timeout time.Duration, maxBody int64) ([]byte, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &RateLimitError{RetryAfter: retryAfterDuration(resp.Header.Get("Retry-After"))}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	return body, nil
+}
+
+// retryAfterDuration parses a Retry-After header's seconds value, falling
+// back to defaultRateLimitRetryAfter when absent or unparseable. (Servers
+// may also send an HTTP-date form; since none of sweep's sources do, that
+// form isn't handled.)
+func retryAfterDuration(header string) time.Duration {
+	if header == "" {
+		return defaultRateLimitRetryAfter
+	}
+	seconds, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil || seconds < 0 {
+		return defaultRateLimitRetryAfter
+	}
+	return time.Duration(seconds) * time.Second
+}
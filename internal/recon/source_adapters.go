@@ -0,0 +1,59 @@
+package recon
+
+import (
+	"context"
+	"time"
+)
+
+// crtshSource adapts CrtshEnumerate to PassiveSource.
+type crtshSource struct{ UserAgent string }
+
+func (s *crtshSource) Name() string  { return "crt.sh" }
+func (s *crtshSource) Enabled() bool { return true }
+func (s *crtshSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return CrtshEnumerate(ctx, domain, s.UserAgent)
+}
+
+// hackertargetSource adapts HackertargetEnumerate to PassiveSource.
+type hackertargetSource struct{ UserAgent string }
+
+func (s *hackertargetSource) Name() string  { return "hackertarget" }
+func (s *hackertargetSource) Enabled() bool { return true }
+func (s *hackertargetSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return HackertargetEnumerate(ctx, domain, s.UserAgent)
+}
+
+// otxSource adapts OTXEnumerate to PassiveSource.
+type otxSource struct{ UserAgent string }
+
+func (s *otxSource) Name() string  { return "otx" }
+func (s *otxSource) Enabled() bool { return true }
+func (s *otxSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return OTXEnumerate(ctx, domain, s.UserAgent)
+}
+
+// DefaultPassiveSources returns the standard set of passive sources:
+// always-on free sources, the authenticated sources for which keys is
+// non-empty, and the CertStream websocket firehose if certStreamDuration
+// is non-zero.
+func DefaultPassiveSources(userAgent string, keys ProviderKeys, certStreamDuration time.Duration) []PassiveSource {
+	return []PassiveSource{
+		&crtshSource{UserAgent: userAgent},
+		&hackertargetSource{UserAgent: userAgent},
+		&otxSource{UserAgent: userAgent},
+		&urlscanSource{},
+		&waybackSource{},
+		&commonCrawlSource{},
+		&bufferoverSource{},
+		&rapiddnsSource{},
+		&dnsdumpsterSource{UserAgent: userAgent},
+		&chaosSource{APIKey: keys.Chaos},
+		&securityTrailsSource{APIKey: keys.SecurityTrails},
+		&virusTotalSource{APIKey: keys.VirusTotal},
+		&shodanSource{APIKey: keys.Shodan},
+		&binaryEdgeSource{APIKey: keys.BinaryEdge},
+		&censysSource{APIID: keys.Censys, APISecret: keys.CensysSecret},
+		&githubSource{APIToken: keys.GitHub},
+		&certStreamSource{Duration: certStreamDuration},
+	}
+}
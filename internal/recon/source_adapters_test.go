@@ -0,0 +1,45 @@
+package recon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultPassiveSourcesEnabledGating(t *testing.T) {
+	sources := DefaultPassiveSources("test-agent", ProviderKeys{Chaos: "key"}, 0)
+
+	enabled := make(map[string]bool)
+	for _, src := range sources {
+		enabled[src.Name()] = src.Enabled()
+	}
+
+	alwaysOn := []string{"crt.sh", "hackertarget", "otx", "urlscan", "wayback", "commoncrawl", "bufferover", "rapiddns", "dnsdumpster"}
+	for _, name := range alwaysOn {
+		if !enabled[name] {
+			t.Errorf("expected %s to always be enabled", name)
+		}
+	}
+
+	if !enabled["chaos"] {
+		t.Error("expected chaos to be enabled when an API key is configured")
+	}
+	for _, name := range []string{"securitytrails", "virustotal", "shodan", "binaryedge", "censys", "github", "certstream"} {
+		if enabled[name] {
+			t.Errorf("expected %s to be disabled without being explicitly configured", name)
+		}
+	}
+
+	withCertStream := DefaultPassiveSources("test-agent", ProviderKeys{}, 30*time.Second)
+	for _, src := range withCertStream {
+		if src.Name() == "certstream" && !src.Enabled() {
+			t.Error("expected certstream to be enabled when a duration is configured")
+		}
+	}
+}
+
+func TestDefaultPassiveSourcesCount(t *testing.T) {
+	sources := DefaultPassiveSources("test-agent", ProviderKeys{}, 0)
+	if len(sources) != 17 {
+		t.Errorf("got %d sources, want 17", len(sources))
+	}
+}
@@ -0,0 +1,200 @@
+package recon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// sourceMinInterval is the minimum spacing enforced between consecutive
+// requests to a single passive source — not a factor on a run's first
+// request to it, only on retries. It exists so a retry-after backoff isn't
+// immediately followed by another request landing within the same second.
+const sourceMinInterval = 1 * time.Second
+
+// sourceRateLimiter paces requests to a single passive source and tracks a
+// retry-after backoff window signaled by that source's own rate-limit
+// response, so a 429 from one source slows only that source instead of
+// stalling the whole run. Unlike synscan's ticker-based rateLimiter, this
+// never blocks a source's first request — it only enforces spacing between
+// calls that actually happen, which matters here since Enumerate normally
+// calls each source just once per domain.
+type sourceRateLimiter struct {
+	mu      sync.Mutex
+	nextOK  time.Time
+	backoff time.Time
+}
+
+func newSourceRateLimiter() *sourceRateLimiter {
+	return &sourceRateLimiter{}
+}
+
+// wait blocks until both the minimum inter-request interval since the last
+// call and any active backoff window have elapsed.
+func (l *sourceRateLimiter) wait(ctx context.Context) {
+	l.mu.Lock()
+	now := time.Now()
+	until := l.nextOK
+	if l.backoff.After(until) {
+		until = l.backoff
+	}
+	l.nextOK = until
+	if l.nextOK.Before(now) {
+		l.nextOK = now
+	}
+	l.nextOK = l.nextOK.Add(sourceMinInterval)
+	l.mu.Unlock()
+
+	if d := time.Until(until); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// penalize extends the backoff window by at least d.
+func (l *sourceRateLimiter) penalize(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.backoff) {
+		l.backoff = until
+	}
+}
+
+// SourceRegistry holds a set of PassiveSources, one rate limiter per
+// source, and runs enumeration across all of them concurrently.
+type SourceRegistry struct {
+	// Progress, if set, receives a Detail line per successful source and a
+	// Warn line per source that ultimately fails.
+	Progress engine.ProgressReporter
+
+	sources []PassiveSource
+
+	mu       sync.Mutex
+	limiters map[string]*sourceRateLimiter
+}
+
+// NewSourceRegistry builds a registry over sources.
+func NewSourceRegistry(sources []PassiveSource) *SourceRegistry {
+	return &SourceRegistry{sources: sources, limiters: make(map[string]*sourceRateLimiter)}
+}
+
+// Sources returns the registry's passive sources.
+func (r *SourceRegistry) Sources() []PassiveSource {
+	return r.sources
+}
+
+// FilterNames returns a new registry containing only sources whose Name()
+// (case-insensitive) appears in names. An empty names is a no-op.
+func (r *SourceRegistry) FilterNames(names []string) *SourceRegistry {
+	if len(names) == 0 {
+		return r
+	}
+	want := toLowerSet(names)
+	var filtered []PassiveSource
+	for _, s := range r.sources {
+		if want[strings.ToLower(s.Name())] {
+			filtered = append(filtered, s)
+		}
+	}
+	return NewSourceRegistry(filtered)
+}
+
+// ExcludeNames returns a new registry with any source whose Name()
+// (case-insensitive) appears in names removed. An empty names is a no-op.
+func (r *SourceRegistry) ExcludeNames(names []string) *SourceRegistry {
+	if len(names) == 0 {
+		return r
+	}
+	exclude := toLowerSet(names)
+	var filtered []PassiveSource
+	for _, s := range r.sources {
+		if !exclude[strings.ToLower(s.Name())] {
+			filtered = append(filtered, s)
+		}
+	}
+	return NewSourceRegistry(filtered)
+}
+
+func toLowerSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[strings.ToLower(n)] = true
+	}
+	return set
+}
+
+func (r *SourceRegistry) limiterFor(name string) *sourceRateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters[name]
+	if !ok {
+		l = newSourceRateLimiter()
+		r.limiters[name] = l
+	}
+	return l
+}
+
+// Enumerate runs every enabled source concurrently against domain. A
+// source that reports rate limiting (a *RateLimitError) is retried once
+// after its limiter's backoff window elapses rather than counted as
+// failed outright. It never returns an error itself: per-source failures
+// come back as warnings alongside whatever hosts the other sources found.
+func (r *SourceRegistry) Enumerate(ctx context.Context, domain string) (hostSources map[string][]string, warnings []string) {
+	hostSources = make(map[string][]string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, src := range r.sources {
+		if !src.Enabled() {
+			continue
+		}
+		wg.Add(1)
+		go func(src PassiveSource) {
+			defer wg.Done()
+			limiter := r.limiterFor(src.Name())
+
+			var hosts []string
+			var err error
+			for attempt := 0; attempt < 2; attempt++ {
+				limiter.wait(ctx)
+				hosts, err = src.Enumerate(ctx, domain)
+
+				var rle *RateLimitError
+				if errors.As(err, &rle) {
+					limiter.penalize(rle.RetryAfter)
+					continue
+				}
+				break
+			}
+
+			if err != nil {
+				if r.Progress != nil {
+					r.Progress.Warn(fmt.Sprintf("%s: %s", src.Name(), err))
+				}
+				mu.Lock()
+				warnings = append(warnings, fmt.Sprintf("%s: %s", src.Name(), err))
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			for _, h := range hosts {
+				hostSources[h] = append(hostSources[h], src.Name())
+			}
+			mu.Unlock()
+			if r.Progress != nil {
+				r.Progress.Detail(fmt.Sprintf("%s: %d subdomains", src.Name(), len(hosts)))
+			}
+		}(src)
+	}
+
+	wg.Wait()
+	return hostSources, warnings
+}
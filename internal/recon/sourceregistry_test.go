@@ -0,0 +1,106 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type fakeSource struct {
+	name    string
+	enabled bool
+	calls   int
+	results func(call int) ([]string, error)
+}
+
+func (s *fakeSource) Name() string  { return s.name }
+func (s *fakeSource) Enabled() bool { return s.enabled }
+func (s *fakeSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	s.calls++
+	return s.results(s.calls)
+}
+
+func TestSourceRegistryEnumerateAggregatesHosts(t *testing.T) {
+	a := &fakeSource{name: "a", enabled: true, results: func(int) ([]string, error) {
+		return []string{"www.example.com"}, nil
+	}}
+	b := &fakeSource{name: "b", enabled: true, results: func(int) ([]string, error) {
+		return []string{"api.example.com"}, nil
+	}}
+	disabled := &fakeSource{name: "c", enabled: false, results: func(int) ([]string, error) {
+		t.Fatal("disabled source should not be queried")
+		return nil, nil
+	}}
+
+	reg := NewSourceRegistry([]PassiveSource{a, b, disabled})
+	hosts, warnings := reg.Enumerate(context.Background(), "example.com")
+
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if srcs, ok := hosts["www.example.com"]; !ok || srcs[0] != "a" {
+		t.Errorf("expected www.example.com from source a, got %v", hosts)
+	}
+	if srcs, ok := hosts["api.example.com"]; !ok || srcs[0] != "b" {
+		t.Errorf("expected api.example.com from source b, got %v", hosts)
+	}
+}
+
+func TestSourceRegistryRetriesOnceAfterRateLimit(t *testing.T) {
+	src := &fakeSource{name: "rl", enabled: true, results: func(call int) ([]string, error) {
+		if call == 1 {
+			return nil, &RateLimitError{RetryAfter: 10 * time.Millisecond}
+		}
+		return []string{"www.example.com"}, nil
+	}}
+
+	reg := NewSourceRegistry([]PassiveSource{src})
+	hosts, warnings := reg.Enumerate(context.Background(), "example.com")
+
+	if len(warnings) != 0 {
+		t.Errorf("expected the retry to succeed with no warnings, got %v", warnings)
+	}
+	if _, ok := hosts["www.example.com"]; !ok {
+		t.Errorf("expected www.example.com after retry, got %v", hosts)
+	}
+	if src.calls != 2 {
+		t.Errorf("expected exactly 2 calls (1 retry), got %d", src.calls)
+	}
+}
+
+func TestSourceRegistryGivesUpAfterRepeatedFailure(t *testing.T) {
+	src := &fakeSource{name: "broken", enabled: true, results: func(int) ([]string, error) {
+		return nil, fmt.Errorf("boom")
+	}}
+
+	reg := NewSourceRegistry([]PassiveSource{src})
+	hosts, warnings := reg.Enumerate(context.Background(), "example.com")
+
+	if len(hosts) != 0 {
+		t.Errorf("expected no hosts, got %v", hosts)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected exactly 1 warning, got %v", warnings)
+	}
+}
+
+func TestSourceRegistryFilterAndExcludeNames(t *testing.T) {
+	a := &fakeSource{name: "a", enabled: true}
+	b := &fakeSource{name: "b", enabled: true}
+	reg := NewSourceRegistry([]PassiveSource{a, b})
+
+	filtered := reg.FilterNames([]string{"A"})
+	if len(filtered.Sources()) != 1 || filtered.Sources()[0].Name() != "a" {
+		t.Errorf("expected only source a, got %v", filtered.Sources())
+	}
+
+	excluded := reg.ExcludeNames([]string{"a"})
+	if len(excluded.Sources()) != 1 || excluded.Sources()[0].Name() != "b" {
+		t.Errorf("expected only source b, got %v", excluded.Sources())
+	}
+
+	if reg.FilterNames(nil) != reg {
+		t.Error("FilterNames(nil) should be a no-op returning the same registry")
+	}
+}
@@ -0,0 +1,58 @@
+package recon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// ErrSYNScanUnavailable is returned by synScan when a raw IPPROTO_TCP
+// socket can't be opened — missing CAP_NET_RAW/root, or an unsupported
+// OS. Callers fall back to a connect scan.
+var ErrSYNScanUnavailable = errors.New("syn scan unavailable: raw sockets not permitted on this platform")
+
+// rateLimiter is a simple token-bucket limiter paced by a ticker, used to
+// keep the SYN scanner's send rate polite enough not to trip IDS
+// thresholds. A zero packetsPerSecond disables limiting.
+type rateLimiter struct {
+	ticker *time.Ticker
+}
+
+func newRateLimiter(packetsPerSecond int) *rateLimiter {
+	if packetsPerSecond <= 0 {
+		return &rateLimiter{}
+	}
+	return &rateLimiter{ticker: time.NewTicker(time.Second / time.Duration(packetsPerSecond))}
+}
+
+func (r *rateLimiter) wait(ctx context.Context) {
+	if r.ticker == nil {
+		return
+	}
+	select {
+	case <-r.ticker.C:
+	case <-ctx.Done():
+	}
+}
+
+func (r *rateLimiter) stop() {
+	if r.ticker != nil {
+		r.ticker.Stop()
+	}
+}
+
+// PortScanSYN performs a half-open SYN scan, falling back transparently
+// to a full connect scan when raw sockets aren't available on this
+// platform or to this process.
+func PortScanSYN(ctx context.Context, dnsRecords []engine.DNSResult, ports []int, concurrency int, timeout time.Duration, packetsPerSecond int) ([]engine.PortResult, error) {
+	results, err := synScan(ctx, dnsRecords, ports, packetsPerSecond)
+	if err == nil {
+		return results, nil
+	}
+	if !errors.Is(err, ErrSYNScanUnavailable) {
+		return nil, err
+	}
+	return PortScan(ctx, dnsRecords, ports, concurrency, timeout)
+}
@@ -0,0 +1,218 @@
+//go:build linux
+
+package recon
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	mathrand "math/rand"
+	"net"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const (
+	tcpFlagSYN = 0x02
+	tcpFlagACK = 0x10
+	tcpFlagRST = 0x04
+)
+
+// synSecret seeds synCookie for the lifetime of the process, so ISNs are
+// unpredictable to an off-path observer but still verifiable by us without
+// keeping per-target state.
+var synSecret = randomSecret()
+
+func randomSecret() [16]byte {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand failing is effectively unrecoverable, but fall back
+		// to a process-unique value rather than a fixed zero secret.
+		binary.BigEndian.PutUint64(b[:8], uint64(time.Now().UnixNano()))
+	}
+	return b
+}
+
+// synCookie derives a stateless initial sequence number from the
+// destination IP and port pair and a per-process secret, so a SYN-ACK's
+// ack number can be verified against the SYN we actually sent without
+// tracking per-target state. This filters out unsolicited or spoofed
+// SYN-ACKs that happen to match our source port but weren't replying to
+// our probe.
+func synCookie(dstIP net.IP, srcPort, dstPort uint16) uint32 {
+	h := fnv.New32a()
+	h.Write(synSecret[:])
+	h.Write(dstIP.To4())
+	binary.Write(h, binary.BigEndian, srcPort)
+	binary.Write(h, binary.BigEndian, dstPort)
+	return h.Sum32()
+}
+
+// synScan sends crafted SYN segments over a raw IPPROTO_TCP socket and
+// classifies replies from a single reader goroutine: SYN-ACK means open,
+// RST means closed, no reply within the grace period means filtered.
+// It never completes the handshake, matching nmap's classic half-open
+// scan. Requires CAP_NET_RAW (or root); returns ErrSYNScanUnavailable if
+// the raw socket can't be opened.
+func synScan(ctx context.Context, dnsRecords []engine.DNSResult, ports []int, packetsPerSecond int) ([]engine.PortResult, error) {
+	targets := buildPortTargets(dnsRecords, ports)
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	mathrand.Shuffle(len(targets), func(i, j int) { targets[i], targets[j] = targets[j], targets[i] })
+
+	recvConn, err := net.ListenIP("ip4:tcp", &net.IPAddr{IP: net.IPv4zero})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSYNScanUnavailable, err)
+	}
+	defer recvConn.Close()
+
+	ipToHost := make(map[string]string, len(dnsRecords))
+	for _, r := range dnsRecords {
+		if len(r.IPs) > 0 {
+			ipToHost[r.IPs[0]] = r.Host
+		}
+	}
+
+	srcPort := uint16(40000 + mathrand.Intn(20000))
+	type verdict struct {
+		ip   string
+		port int
+		open bool
+	}
+	replies := make(chan verdict, len(targets))
+
+	readerDone := make(chan struct{})
+	go func() {
+		defer close(readerDone)
+		buf := make([]byte, 1500)
+		for {
+			n, addr, err := recvConn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			seg := buf[:n]
+			if len(seg) < 20 {
+				continue
+			}
+			dstPort := binary.BigEndian.Uint16(seg[2:4])
+			if dstPort != srcPort {
+				continue // not a reply to one of our SYNs
+			}
+			replyPort := binary.BigEndian.Uint16(seg[0:2])
+			ack := binary.BigEndian.Uint32(seg[8:12])
+			if ack != synCookie(net.ParseIP(addr.String()), srcPort, replyPort)+1 {
+				continue // ack doesn't match our cookie: unsolicited/spoofed SYN-ACK
+			}
+			flags := seg[13]
+			replies <- verdict{
+				ip:   addr.String(),
+				port: int(replyPort),
+				open: flags&tcpFlagSYN != 0 && flags&tcpFlagACK != 0,
+			}
+		}
+	}()
+
+	limiter := newRateLimiter(packetsPerSecond)
+	defer limiter.stop()
+
+sendLoop:
+	for _, t := range targets {
+		select {
+		case <-ctx.Done():
+			break sendLoop
+		default:
+		}
+		limiter.wait(ctx)
+
+		srcIP, err := localOutboundIP(t.ip)
+		if err != nil {
+			continue
+		}
+		seg := buildSYNSegment(srcPort, uint16(t.port), srcIP, net.ParseIP(t.ip))
+		_, _ = recvConn.WriteToIP(seg, &net.IPAddr{IP: net.ParseIP(t.ip)})
+	}
+
+	open := make(map[portTarget]bool)
+collect:
+	for {
+		select {
+		case v := <-replies:
+			if v.open {
+				open[portTarget{host: ipToHost[v.ip], ip: v.ip, port: v.port}] = true
+			}
+		case <-time.After(2 * time.Second):
+			break collect
+		case <-ctx.Done():
+			break collect
+		}
+	}
+
+	var results []engine.PortResult
+	for t := range open {
+		results = append(results, engine.PortResult{Host: t.host, IP: t.ip, Port: t.port})
+	}
+	return results, nil
+}
+
+// localOutboundIP finds the local address the kernel would use to reach
+// dst, needed to fill in the TCP pseudo-header checksum since we never
+// actually establish a connection.
+func localOutboundIP(dst string) (net.IP, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(dst, "80"))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP, nil
+}
+
+// buildSYNSegment constructs a bare 20-byte TCP SYN segment (no options)
+// with a valid checksum. The kernel fills in the IP header for us since
+// we write through an "ip4:tcp" socket.
+func buildSYNSegment(srcPort, dstPort uint16, srcIP, dstIP net.IP) []byte {
+	seg := make([]byte, 20)
+	binary.BigEndian.PutUint16(seg[0:2], srcPort)
+	binary.BigEndian.PutUint16(seg[2:4], dstPort)
+	binary.BigEndian.PutUint32(seg[4:8], synCookie(dstIP, srcPort, dstPort)) // ISN
+	binary.BigEndian.PutUint32(seg[8:12], 0)                                 // ack
+	seg[12] = 5 << 4                                                         // data offset: 5 words, no options
+	seg[13] = tcpFlagSYN
+	binary.BigEndian.PutUint16(seg[14:16], 64240) // window
+	binary.BigEndian.PutUint16(seg[18:20], 0)     // urgent pointer
+
+	checksum := tcpChecksum(srcIP, dstIP, seg)
+	binary.BigEndian.PutUint16(seg[16:18], checksum)
+	return seg
+}
+
+// tcpChecksum computes the TCP checksum over the IPv4 pseudo-header and
+// segment per RFC 793.
+func tcpChecksum(srcIP, dstIP net.IP, segment []byte) uint16 {
+	pseudo := make([]byte, 0, 12+len(segment))
+	pseudo = append(pseudo, srcIP.To4()...)
+	pseudo = append(pseudo, dstIP.To4()...)
+	pseudo = append(pseudo, 0, 6) // zero, protocol = TCP
+	length := make([]byte, 2)
+	binary.BigEndian.PutUint16(length, uint16(len(segment)))
+	pseudo = append(pseudo, length...)
+	pseudo = append(pseudo, segment...)
+	return onesComplementChecksum(pseudo)
+}
+
+func onesComplementChecksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 > 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
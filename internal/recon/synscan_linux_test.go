@@ -0,0 +1,24 @@
+//go:build linux
+
+package recon
+
+import (
+	"net"
+	"testing"
+)
+
+func TestSynCookie_DeterministicAndSensitiveToInputs(t *testing.T) {
+	ip := net.ParseIP("203.0.113.5")
+	a := synCookie(ip, 40001, 443)
+	b := synCookie(ip, 40001, 443)
+	if a != b {
+		t.Errorf("synCookie not deterministic: %d != %d", a, b)
+	}
+
+	if c := synCookie(ip, 40001, 80); c == a {
+		t.Error("expected a different cookie for a different destination port")
+	}
+	if c := synCookie(net.ParseIP("203.0.113.6"), 40001, 443); c == a {
+		t.Error("expected a different cookie for a different destination IP")
+	}
+}
@@ -0,0 +1,69 @@
+package recon
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func TestPortScanSYN_FallsBackToConnectScan(t *testing.T) {
+	// synScan requires CAP_NET_RAW, which test processes don't have, so
+	// PortScanSYN should transparently fall back to a connect scan.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	port := ln.Addr().(*net.TCPAddr).Port
+	dnsRecords := []engine.DNSResult{{Host: "localhost", IPs: []string{"127.0.0.1"}}}
+
+	results, err := PortScanSYN(context.Background(), dnsRecords, []int{port}, 5, 2*time.Second, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].Port != port {
+		t.Errorf("results = %v, want one result on port %d", results, port)
+	}
+}
+
+func TestPortScanResume_KeepsPriorAndAddsNewPorts(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	port := ln.Addr().(*net.TCPAddr).Port
+	dnsRecords := []engine.DNSResult{{Host: "localhost", IPs: []string{"127.0.0.1"}}}
+	prior := []engine.PortResult{{Host: "localhost", IP: "127.0.0.1", Port: 9999}} // not actually open, but already "recorded"
+
+	results, err := PortScanResume(context.Background(), dnsRecords, []int{port}, 5, 2*time.Second, prior)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("results = %v, want prior entry plus newly found port", results)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package recon
+
+import (
+	"context"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+// synScan is only implemented on Linux, where a raw IPPROTO_TCP socket
+// under CAP_NET_RAW is available. Other platforms fall back to a
+// connect scan.
+func synScan(ctx context.Context, dnsRecords []engine.DNSResult, ports []int, packetsPerSecond int) ([]engine.PortResult, error) {
+	return nil, ErrSYNScanUnavailable
+}
@@ -0,0 +1,134 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// maxExpandedIPs bounds how many addresses a single CIDR block or ASN can
+// expand to in one invocation, so a stray /8 or a large origin AS doesn't
+// silently turn one sweep into a multi-million-host scan. Expansion stops
+// once the bound is hit and ExpandTargets reports it as a warning rather
+// than failing outright.
+const maxExpandedIPs = 65536
+
+// Target is a single concrete scan target alongside the literal input it
+// came from, so CIDR- and ASN-expanded runs can tag their ScanResult with
+// where that IP was discovered.
+type Target struct {
+	// Value is what's actually scanned: a domain name or a single IP.
+	Value string
+	// Origin is the literal CLI/--list input Value was expanded from (a
+	// domain, a CIDR block, or an ASN). Equal to Value when no expansion
+	// happened.
+	Origin string
+	// IsIP is true when Value is a bare IP address rather than a domain,
+	// so callers can route it through IPEnumerator instead of the normal
+	// passive-DNS enumerator.
+	IsIP bool
+}
+
+var asnPattern = regexp.MustCompile(`(?i)^AS\d+$`)
+
+// ExpandTargets resolves each raw input into one or more concrete Targets:
+// a bare domain passes through unchanged, a CIDR block expands to its
+// member IPs, and an ASN ("AS13335") expands to the IPs across every
+// prefix it originates. Expansion is capped at maxExpandedIPs per input;
+// hitting the cap is reported as a warning, not an error.
+func ExpandTargets(ctx context.Context, inputs []string) ([]Target, []string, error) {
+	var targets []Target
+	var warnings []string
+
+	for _, raw := range inputs {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		switch {
+		case asnPattern.MatchString(raw):
+			expanded, warns, err := expandASN(ctx, raw)
+			if err != nil {
+				return nil, nil, err
+			}
+			targets = append(targets, expanded...)
+			warnings = append(warnings, warns...)
+
+		case strings.Contains(raw, "/"):
+			expanded, warn := expandCIDR(raw, raw)
+			if expanded == nil {
+				return nil, nil, fmt.Errorf("invalid CIDR %q", raw)
+			}
+			targets = append(targets, expanded...)
+			if warn != "" {
+				warnings = append(warnings, warn)
+			}
+
+		case net.ParseIP(raw) != nil:
+			targets = append(targets, Target{Value: raw, Origin: raw, IsIP: true})
+
+		default:
+			targets = append(targets, Target{Value: strings.ToLower(raw), Origin: strings.ToLower(raw)})
+		}
+	}
+
+	return targets, warnings, nil
+}
+
+func expandASN(ctx context.Context, asn string) ([]Target, []string, error) {
+	prefixes, err := ASNPrefixes(ctx, asn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var targets []Target
+	var warnings []string
+	remaining := maxExpandedIPs
+	for i, prefix := range prefixes {
+		if remaining <= 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: stopped expansion at %d IPs, %d more prefixes skipped", asn, maxExpandedIPs, len(prefixes)-i))
+			break
+		}
+		expanded, _ := expandCIDR(prefix, asn)
+		if len(expanded) > remaining {
+			expanded = expanded[:remaining]
+			warnings = append(warnings, fmt.Sprintf("%s: stopped expansion at %d IPs", asn, maxExpandedIPs))
+		}
+		targets = append(targets, expanded...)
+		remaining -= len(expanded)
+	}
+	return targets, warnings, nil
+}
+
+// expandCIDR returns every IP in cidr, network and broadcast addresses
+// included, tagged with origin, capped at maxExpandedIPs. Returns nil if cidr doesn't parse.
+func expandCIDR(cidr, origin string) ([]Target, string) {
+	ip, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, ""
+	}
+
+	var targets []Target
+	warn := ""
+	for addr := ip.Mask(ipnet.Mask); ipnet.Contains(addr); incIP(addr) {
+		if len(targets) >= maxExpandedIPs {
+			warn = fmt.Sprintf("%s: stopped expansion at %d IPs", origin, maxExpandedIPs)
+			break
+		}
+		targets = append(targets, Target{Value: addr.String(), Origin: origin, IsIP: true})
+	}
+	return targets, warn
+}
+
+// incIP increments ip in place, treating it as a big-endian counter.
+func incIP(ip net.IP) {
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			return
+		}
+	}
+}
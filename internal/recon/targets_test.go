@@ -0,0 +1,84 @@
+package recon
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExpandTargetsPlainDomainPassesThrough(t *testing.T) {
+	targets, warnings, err := ExpandTargets(context.Background(), []string{"Example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if len(targets) != 1 || targets[0].Value != "example.com" || targets[0].IsIP {
+		t.Fatalf("got %+v", targets)
+	}
+}
+
+func TestExpandTargetsBareIP(t *testing.T) {
+	targets, _, err := ExpandTargets(context.Background(), []string{"10.0.0.5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 1 || !targets[0].IsIP || targets[0].Value != "10.0.0.5" {
+		t.Fatalf("got %+v", targets)
+	}
+}
+
+func TestExpandTargetsCIDR(t *testing.T) {
+	targets, _, err := ExpandTargets(context.Background(), []string{"10.0.0.0/30"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}
+	if len(targets) != len(want) {
+		t.Fatalf("got %d targets, want %d: %+v", len(targets), len(want), targets)
+	}
+	for i, w := range want {
+		if targets[i].Value != w || targets[i].Origin != "10.0.0.0/30" || !targets[i].IsIP {
+			t.Errorf("targets[%d] = %+v, want Value %s", i, targets[i], w)
+		}
+	}
+}
+
+func TestExpandTargetsInvalidCIDR(t *testing.T) {
+	if _, _, err := ExpandTargets(context.Background(), []string{"10.0.0.0/99"}); err == nil {
+		t.Fatal("expected error for invalid CIDR")
+	}
+}
+
+func TestExpandTargetsMultipleInputs(t *testing.T) {
+	targets, _, err := ExpandTargets(context.Background(), []string{"example.com", "10.0.0.0/31"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 3 {
+		t.Fatalf("got %d targets, want 3: %+v", len(targets), targets)
+	}
+}
+
+func TestASNPatternMatching(t *testing.T) {
+	for _, s := range []string{"AS13335", "as13335"} {
+		if !asnPattern.MatchString(s) {
+			t.Errorf("expected %q to match ASN pattern", s)
+		}
+	}
+	for _, s := range []string{"example.com", "AS", "13335"} {
+		if asnPattern.MatchString(s) {
+			t.Errorf("expected %q not to match ASN pattern", s)
+		}
+	}
+}
+
+func TestIPEnumeratorReturnsTheIPItself(t *testing.T) {
+	subs, err := IPEnumerator{}.Enumerate(context.Background(), "10.0.0.1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subs) != 1 || subs[0].Host != "10.0.0.1" {
+		t.Fatalf("got %+v", subs)
+	}
+}
@@ -0,0 +1,70 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	urlscanBaseURL = "https://urlscan.io/api/v1/search/?q=domain:%s"
+	urlscanTimeout = 15 * time.Second
+	urlscanMaxBody = 20 * 1024 * 1024 // 20MB
+)
+
+type urlscanResponse struct {
+	Results []struct {
+		Page struct {
+			Domain string `json:"domain"`
+		} `json:"page"`
+	} `json:"results"`
+}
+
+// URLScanEnumerate queries urlscan.io's public search API for hostnames
+// seen scanning the target domain. No API key required.
+func URLScanEnumerate(ctx context.Context, domain string) ([]string, error) {
+	url := fmt.Sprintf(urlscanBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, nil, urlscanTimeout, urlscanMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("urlscan fetch for %s: %w", domain, err)
+	}
+
+	return parseURLScanResponse(body, domain)
+}
+
+// parseURLScanResponse extracts in-scope hostnames from urlscan.io's search
+// results.
+func parseURLScanResponse(body []byte, domain string) ([]string, error) {
+	var resp urlscanResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("urlscan JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, r := range resp.Results {
+		host := strings.ToLower(strings.TrimSpace(r.Page.Domain))
+		if host == "" {
+			continue
+		}
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// urlscanSource adapts URLScanEnumerate to PassiveSource.
+type urlscanSource struct{}
+
+func (s *urlscanSource) Name() string  { return "urlscan" }
+func (s *urlscanSource) Enabled() bool { return true }
+func (s *urlscanSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return URLScanEnumerate(ctx, domain)
+}
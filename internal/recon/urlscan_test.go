@@ -0,0 +1,15 @@
+package recon
+
+import "testing"
+
+func TestParseURLScanResponse(t *testing.T) {
+	body := []byte(`{"results":[{"page":{"domain":"www.example.com"}},{"page":{"domain":"other.notexample.com"}}]}`)
+
+	hosts, err := parseURLScanResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
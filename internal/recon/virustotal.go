@@ -0,0 +1,69 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	virusTotalBaseURL = "https://www.virustotal.com/api/v3/domains/%s/subdomains?limit=40"
+	virusTotalTimeout = 15 * time.Second
+	virusTotalMaxBody = 10 * 1024 * 1024 // 10MB
+)
+
+type virusTotalResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// VirusTotalEnumerate queries the VirusTotal v3 domain-subdomains API.
+// Requires an API key; returns an error if apiKey is empty.
+func VirusTotalEnumerate(ctx context.Context, domain, apiKey string) ([]string, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("virustotal: no API key configured")
+	}
+
+	url := fmt.Sprintf(virusTotalBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, url, map[string]string{"x-apikey": apiKey}, virusTotalTimeout, virusTotalMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("virustotal fetch for %s: %w", domain, err)
+	}
+
+	return parseVirusTotalResponse(body)
+}
+
+// parseVirusTotalResponse extracts hostnames from a VirusTotal v3
+// subdomains response.
+func parseVirusTotalResponse(body []byte) ([]string, error) {
+	var resp virusTotalResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("virustotal JSON parse: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, d := range resp.Data {
+		host := strings.ToLower(strings.TrimSpace(d.ID))
+		if host == "" {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// virusTotalSource adapts VirusTotalEnumerate to PassiveSource.
+type virusTotalSource struct{ APIKey string }
+
+func (s *virusTotalSource) Name() string  { return "virustotal" }
+func (s *virusTotalSource) Enabled() bool { return s.APIKey != "" }
+func (s *virusTotalSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return VirusTotalEnumerate(ctx, domain, s.APIKey)
+}
@@ -0,0 +1,23 @@
+package recon
+
+import "testing"
+
+func TestVirusTotalEnumerateRequiresAPIKey(t *testing.T) {
+	if _, err := VirusTotalEnumerate(nil, "example.com", ""); err == nil {
+		t.Fatal("expected error with no API key")
+	}
+}
+
+func TestParseVirusTotalResponse(t *testing.T) {
+	body := []byte(`{"data":[{"id":"www.example.com"},{"id":"api.example.com"}]}`)
+
+	hosts, err := parseVirusTotalResponse(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]bool{"www.example.com": true, "api.example.com": true}
+	if len(hosts) != len(expected) {
+		t.Fatalf("got %d hosts, want %d: %v", len(hosts), len(expected), hosts)
+	}
+}
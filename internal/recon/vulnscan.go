@@ -0,0 +1,365 @@
+package recon
+
+import (
+	"context"
+	"crypto/tls"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed templates.yaml
+var builtinTemplatesYAML []byte
+
+const vulnScanMaxBody = 256 * 1024
+
+// templateSpec mirrors the on-disk YAML schema for one vulnerability check.
+type templateSpec struct {
+	ID       string   `yaml:"id"`
+	Name     string   `yaml:"name"`
+	Severity string   `yaml:"severity"`
+	CVE      string   `yaml:"cve"`
+	Tech     []string `yaml:"tech"`
+	Path     string   `yaml:"path"`
+	Matchers []struct {
+		Status []int  `yaml:"status"`
+		Word   string `yaml:"word"`
+		Regex  string `yaml:"regex"`
+		Part   string `yaml:"part"`   // "body" (default) or "header"
+		Header string `yaml:"header"` // header name, only used when part is "header"
+	} `yaml:"matchers"`
+}
+
+// Template is the compiled, in-memory form of a templateSpec.
+type Template struct {
+	ID       string
+	Name     string
+	Severity string
+	CVE      string
+	Tech     []string
+	Path     string
+	Matchers []templateMatcher
+}
+
+type templateMatcher struct {
+	status []int
+	regex  *regexp.Regexp
+	part   string // "body" or "header"
+	header string
+}
+
+// appliesTo reports whether t should be checked against svc, based on its
+// Tech gate: empty runs against every service, otherwise svc must have
+// fingerprinted a technology whose name contains one of t.Tech
+// (case-insensitive).
+func (t Template) appliesTo(svc engine.HTTPService) bool {
+	if len(t.Tech) == 0 {
+		return true
+	}
+	for _, want := range t.Tech {
+		for _, tech := range svc.Technologies {
+			if strings.Contains(strings.ToLower(tech.Name), strings.ToLower(want)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches reports whether every one of t's matchers is satisfied by the
+// given response.
+func (t Template) matches(status int, headers map[string]string, body string) bool {
+	for _, m := range t.Matchers {
+		if len(m.status) > 0 {
+			found := false
+			for _, s := range m.status {
+				if s == status {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if m.regex != nil {
+			haystack := body
+			if m.part == "header" {
+				haystack = headers[m.header]
+			}
+			if !m.regex.MatchString(haystack) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func compileTemplates(specs []templateSpec) ([]Template, error) {
+	templates := make([]Template, 0, len(specs))
+	for _, s := range specs {
+		t := Template{ID: s.ID, Name: s.Name, Severity: s.Severity, CVE: s.CVE, Tech: s.Tech, Path: s.Path}
+		for _, m := range s.Matchers {
+			tm := templateMatcher{status: m.Status, part: m.Part, header: strings.ToLower(m.Header)}
+			if tm.part == "" {
+				tm.part = "body"
+			}
+			switch {
+			case m.Regex != "":
+				re, err := regexp.Compile(m.Regex)
+				if err != nil {
+					return nil, fmt.Errorf("template %q: matcher regex: %w", s.ID, err)
+				}
+				tm.regex = re
+			case m.Word != "":
+				tm.regex = regexp.MustCompile("(?i)" + regexp.QuoteMeta(m.Word))
+			}
+			t.Matchers = append(t.Matchers, tm)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+var (
+	templatesMu   sync.Mutex
+	templates     []Template
+	templatesOnce sync.Once
+)
+
+func loadBuiltinTemplates() {
+	templatesOnce.Do(func() {
+		var specs []templateSpec
+		if err := yaml.Unmarshal(builtinTemplatesYAML, &specs); err != nil {
+			return
+		}
+		compiled, err := compileTemplates(specs)
+		if err != nil {
+			return
+		}
+		templatesMu.Lock()
+		templates = compiled
+		templatesMu.Unlock()
+	})
+}
+
+// activeTemplates returns the currently loaded template set, lazily
+// compiling the bundled starter set on first use.
+func activeTemplates() []Template {
+	loadBuiltinTemplates()
+	templatesMu.Lock()
+	defer templatesMu.Unlock()
+	return templates
+}
+
+// LoadTemplatesDir replaces the active template set with every *.yaml/*.yml
+// file in dir, letting operators extend or swap the bundled starter set
+// without recompiling sweep.
+func LoadTemplatesDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("read templates dir %s: %w", dir, err)
+	}
+
+	var loaded []Template
+	for _, e := range entries {
+		if e.IsDir() || (!strings.HasSuffix(e.Name(), ".yaml") && !strings.HasSuffix(e.Name(), ".yml")) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		var specs []templateSpec
+		if err := yaml.Unmarshal(data, &specs); err != nil {
+			continue
+		}
+		compiled, err := compileTemplates(specs)
+		if err != nil {
+			continue
+		}
+		loaded = append(loaded, compiled...)
+	}
+
+	if len(loaded) == 0 {
+		return fmt.Errorf("no templates found in %s", dir)
+	}
+
+	// Run the one-time built-in load now so it can't overwrite this set later.
+	loadBuiltinTemplates()
+	templatesMu.Lock()
+	templates = loaded
+	templatesMu.Unlock()
+	return nil
+}
+
+// severityRank orders severities from least to most serious, for --severity
+// filtering.
+var severityRank = map[string]int{
+	"info":     0,
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// VulnScanner implements engine.VulnScanner: it runs the active template
+// set against each probed HTTP service, re-requesting a template's Path
+// when one is set and reusing the service's already-probed response
+// (via Prober's captured body/headers) otherwise.
+type VulnScanner struct {
+	UserAgent string
+	// Prober, if set, is consulted for a service's already-captured body
+	// and headers when a template has no Path. A nil Prober means
+	// templates with an empty Path never match anything but status.
+	Prober *Prober
+	// MinSeverity, if set, drops findings below this severity (one of
+	// "info", "low", "medium", "high", "critical"). Empty reports every
+	// match.
+	MinSeverity string
+}
+
+type vulnCheckJob struct {
+	svc engine.HTTPService
+	t   Template
+}
+
+// Scan implements engine.VulnScanner.
+func (v *VulnScanner) Scan(ctx context.Context, services []engine.HTTPService, concurrency int, timeout time.Duration) ([]engine.Finding, error) {
+	tmpls := activeTemplates()
+	if len(tmpls) == 0 {
+		return nil, nil
+	}
+
+	minRank := -1
+	if v.MinSeverity != "" {
+		if r, ok := severityRank[strings.ToLower(v.MinSeverity)]; ok {
+			minRank = r
+		}
+	}
+
+	var jobs []vulnCheckJob
+	for _, svc := range services {
+		for _, t := range tmpls {
+			if t.appliesTo(svc) {
+				jobs = append(jobs, vulnCheckJob{svc: svc, t: t})
+			}
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	var pd map[string]*probeData
+	if v.Prober != nil {
+		v.Prober.mu.Lock()
+		pd = v.Prober.ProbeData
+		v.Prober.mu.Unlock()
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	work := make(chan vulnCheckJob, len(jobs))
+	for _, j := range jobs {
+		work <- j
+	}
+	close(work)
+
+	var wg sync.WaitGroup
+	var resMu sync.Mutex
+	var findings []engine.Finding
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range work {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				finding, ok := checkOne(ctx, client, j.svc, j.t, v.UserAgent, pd[j.svc.URL])
+				if !ok {
+					continue
+				}
+				if minRank >= 0 && severityRank[strings.ToLower(finding.Severity)] < minRank {
+					continue
+				}
+				resMu.Lock()
+				findings = append(findings, finding)
+				resMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return findings, nil
+}
+
+func checkOne(ctx context.Context, client *http.Client, svc engine.HTTPService, t Template, userAgent string, probed *probeData) (engine.Finding, bool) {
+	url := svc.URL
+	status := svc.StatusCode
+	var headers map[string]string
+	var body string
+
+	if t.Path == "" {
+		if probed != nil {
+			headers = probed.headers
+			body = probed.body
+		}
+	} else {
+		url = strings.TrimRight(svc.URL, "/") + t.Path
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return engine.Finding{}, false
+		}
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return engine.Finding{}, false
+		}
+		defer resp.Body.Close()
+
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, vulnScanMaxBody))
+		body = string(raw)
+		status = resp.StatusCode
+		headers = make(map[string]string, len(resp.Header))
+		for name, vals := range resp.Header {
+			if len(vals) > 0 {
+				headers[strings.ToLower(name)] = vals[0]
+			}
+		}
+	}
+
+	if !t.matches(status, headers, body) {
+		return engine.Finding{}, false
+	}
+
+	return engine.Finding{
+		Template:  t.ID,
+		Name:      t.Name,
+		Severity:  t.Severity,
+		MatchedAt: url,
+		CVE:       t.CVE,
+	}, true
+}
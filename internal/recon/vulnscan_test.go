@@ -0,0 +1,178 @@
+package recon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func TestTemplateAppliesTo_TechGate(t *testing.T) {
+	tmpl := Template{Tech: []string{"Grafana"}}
+	svc := engine.HTTPService{Technologies: []engine.Technology{{Name: "Grafana"}}}
+
+	if !tmpl.appliesTo(svc) {
+		t.Error("expected template to apply when a fingerprinted tech matches Tech")
+	}
+	if tmpl.appliesTo(engine.HTTPService{Technologies: []engine.Technology{{Name: "nginx"}}}) {
+		t.Error("expected template not to apply when no fingerprinted tech matches Tech")
+	}
+	if !(Template{}).appliesTo(engine.HTTPService{}) {
+		t.Error("expected an empty Tech gate to apply to every service")
+	}
+}
+
+func TestTemplateMatches_AllMatchersAND(t *testing.T) {
+	specs := []templateSpec{{
+		ID: "test", Severity: "low",
+		Matchers: []struct {
+			Status []int  `yaml:"status"`
+			Word   string `yaml:"word"`
+			Regex  string `yaml:"regex"`
+			Part   string `yaml:"part"`
+			Header string `yaml:"header"`
+		}{
+			{Status: []int{200}},
+			{Word: "[core]"},
+		},
+	}}
+	tmpls, err := compileTemplates(specs)
+	if err != nil {
+		t.Fatalf("compileTemplates: %v", err)
+	}
+	tmpl := tmpls[0]
+
+	if !tmpl.matches(200, nil, "[core]\nrepositoryformatversion = 0") {
+		t.Error("expected a matching status and body word to match")
+	}
+	if tmpl.matches(404, nil, "[core]") {
+		t.Error("expected a mismatched status to fail the match")
+	}
+	if tmpl.matches(200, nil, "not a git config") {
+		t.Error("expected a mismatched body word to fail the match")
+	}
+}
+
+func TestVulnScanner_Scan_FetchesPathAndReportsFinding(t *testing.T) {
+	// Exercises the bundled exposed-git-config starter template, which
+	// applies to every service (no Tech gate) and re-fetches its Path.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/.git/config" {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("[core]\nrepositoryformatversion = 0"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	v := &VulnScanner{}
+	svc := engine.HTTPService{URL: srv.URL}
+	findings, err := v.Scan(context.Background(), []engine.HTTPService{svc}, 2, srv.Client().Timeout)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Template != "exposed-git-config" {
+		t.Fatalf("findings = %+v, want one exposed-git-config finding", findings)
+	}
+}
+
+func TestVulnScanner_Scan_EmptyPathReusesProbedResponse(t *testing.T) {
+	loadTestTemplates(t, `
+- id: root-banner
+  name: Acme CMS banner on the root page
+  severity: low
+  matchers:
+    - word: "powered by acme"
+      part: body
+`)
+
+	prober := &Prober{ProbeData: map[string]*probeData{
+		"http://svc.example.com": {body: "Powered by Acme CMS"},
+	}}
+	v := &VulnScanner{Prober: prober}
+	svc := engine.HTTPService{URL: "http://svc.example.com"}
+
+	findings, err := v.Scan(context.Background(), []engine.HTTPService{svc}, 1, time.Second)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Template != "root-banner" {
+		t.Fatalf("findings = %+v, want one root-banner finding reusing the probed body", findings)
+	}
+}
+
+func TestVulnScanner_Scan_EmptyPathWithoutProberNeverMatchesBody(t *testing.T) {
+	loadTestTemplates(t, `
+- id: root-banner
+  name: Acme CMS banner on the root page
+  severity: low
+  matchers:
+    - word: "powered by acme"
+      part: body
+`)
+
+	v := &VulnScanner{}
+	svc := engine.HTTPService{URL: "http://svc.example.com"}
+
+	findings, err := v.Scan(context.Background(), []engine.HTTPService{svc}, 1, time.Second)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("findings = %+v, want none without a Prober to supply the probed body", findings)
+	}
+}
+
+// loadTestTemplates points the package's active template set at a
+// single-file temp directory containing yamlBody, restoring the prior
+// active set once the test completes.
+func loadTestTemplates(t *testing.T, yamlBody string) {
+	t.Helper()
+	dir := t.TempDir()
+	path := dir + "/templates.yaml"
+	if err := os.WriteFile(path, []byte(yamlBody), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	templatesMu.Lock()
+	prevTemplates := templates
+	templatesMu.Unlock()
+	t.Cleanup(func() {
+		templatesMu.Lock()
+		templates = prevTemplates
+		templatesMu.Unlock()
+	})
+
+	if err := LoadTemplatesDir(dir); err != nil {
+		t.Fatalf("LoadTemplatesDir: %v", err)
+	}
+}
+
+func TestVulnScanner_Scan_MinSeverityFiltersFindings(t *testing.T) {
+	// exposed-git-config is "medium" severity; requiring "critical" should
+	// drop it even though its matchers fire.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/.git/config" {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("[core]\nrepositoryformatversion = 0"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	v := &VulnScanner{MinSeverity: "critical"}
+	svc := engine.HTTPService{URL: srv.URL}
+	findings, err := v.Scan(context.Background(), []engine.HTTPService{svc}, 1, srv.Client().Timeout)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("findings = %+v, want none below --severity critical", findings)
+	}
+}
@@ -0,0 +1,69 @@
+package recon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	waybackBaseURL = "https://web.archive.org/cdx/search/cdx?url=*.%s/*&output=json&fl=original&collapse=urlkey"
+	waybackTimeout = 30 * time.Second
+	waybackMaxBody = 50 * 1024 * 1024 // 50MB
+)
+
+// WaybackEnumerate queries the Wayback Machine's CDX API for archived URLs
+// under the target domain and extracts their hostnames. No API key
+// required. The CDX response is a JSON array of rows, the first of which
+// is the column header rather than data.
+func WaybackEnumerate(ctx context.Context, domain string) ([]string, error) {
+	reqURL := fmt.Sprintf(waybackBaseURL, domain)
+	body, err := sourceHTTPGet(ctx, reqURL, nil, waybackTimeout, waybackMaxBody)
+	if err != nil {
+		return nil, fmt.Errorf("wayback fetch for %s: %w", domain, err)
+	}
+
+	return parseWaybackResponse(body, domain)
+}
+
+// parseWaybackResponse extracts in-scope hostnames from the Wayback CDX
+// API's JSON-array-of-rows response, skipping the header row.
+func parseWaybackResponse(body []byte, domain string) ([]string, error) {
+	var rows [][]string
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return nil, fmt.Errorf("wayback JSON parse for %s: %w", domain, err)
+	}
+
+	seen := make(map[string]bool)
+	var hosts []string
+	for i, row := range rows {
+		if i == 0 || len(row) == 0 {
+			continue // header row
+		}
+		u, err := url.Parse(row[0])
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		host := strings.ToLower(u.Hostname())
+		if !strings.HasSuffix(host, "."+domain) && host != domain {
+			continue
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// waybackSource adapts WaybackEnumerate to PassiveSource.
+type waybackSource struct{}
+
+func (s *waybackSource) Name() string  { return "wayback" }
+func (s *waybackSource) Enabled() bool { return true }
+func (s *waybackSource) Enumerate(ctx context.Context, domain string) ([]string, error) {
+	return WaybackEnumerate(ctx, domain)
+}
@@ -0,0 +1,15 @@
+package recon
+
+import "testing"
+
+func TestParseWaybackResponseSkipsHeaderRow(t *testing.T) {
+	body := []byte(`[["original"],["https://www.example.com/path"],["https://other.notexample.com/"]]`)
+
+	hosts, err := parseWaybackResponse(body, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hosts) != 1 || hosts[0] != "www.example.com" {
+		t.Errorf("got %v, want [www.example.com]", hosts)
+	}
+}
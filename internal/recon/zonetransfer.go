@@ -53,6 +53,8 @@ func AttemptZoneTransfers(ctx context.Context, domain string) (*ZoneTransferResu
 		hostnames, err := attemptAXFR(ctx, domain, nsHost)
 		if err != nil {
 			// AXFR failure is expected for most domains — not an error.
+			// NSECWalker separately covers the DNSSEC-signed case, since
+			// walking NSEC/NSEC3 isn't an AXFR attempt at all.
 			result.Transfers = append(result.Transfers, transfer)
 			continue
 		}
@@ -0,0 +1,146 @@
+// Package takeover verifies dangling-CNAME candidates against a database
+// of known SaaS subdomain-takeover fingerprints, upgrading a raw
+// NXDOMAIN/SERVFAIL detection into a confirmed, vulnerable, or unclaimed
+// finding with service attribution and evidence.
+package takeover
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed signatures.yaml
+var builtinSignaturesYAML []byte
+
+// signatureSpec mirrors the on-disk YAML schema for one service's
+// takeover fingerprint.
+type signatureSpec struct {
+	Service         string   `yaml:"service"`
+	CNAMEPatterns   []string `yaml:"cname_patterns"`
+	HTTPFingerprint struct {
+		Status int    `yaml:"status"`
+		Body   string `yaml:"body"`
+	} `yaml:"http_fingerprint"`
+	NXDomainOnly bool     `yaml:"nxdomain_only"`
+	CVE          string   `yaml:"cve"`
+	References   []string `yaml:"references"`
+	Severity     string   `yaml:"severity"`
+}
+
+// Signature is the compiled, in-memory form of a signatureSpec.
+type Signature struct {
+	Service       string
+	CNAMEPatterns []*regexp.Regexp
+	HTTPStatus    int // 0 means any status satisfies the fingerprint
+	HTTPBody      *regexp.Regexp
+	NXDomainOnly  bool
+	CVE           string
+	References    []string
+	Severity      string
+}
+
+// Matches reports whether cname is a known takeover target for sig.
+func (sig Signature) Matches(cname string) bool {
+	for _, re := range sig.CNAMEPatterns {
+		if re.MatchString(cname) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileSignatures(specs []signatureSpec) ([]Signature, error) {
+	sigs := make([]Signature, 0, len(specs))
+	for _, s := range specs {
+		sig := Signature{
+			Service:      s.Service,
+			HTTPStatus:   s.HTTPFingerprint.Status,
+			NXDomainOnly: s.NXDomainOnly,
+			CVE:          s.CVE,
+			References:   s.References,
+			Severity:     s.Severity,
+		}
+		for _, p := range s.CNAMEPatterns {
+			re, err := regexp.Compile("(?i)" + p)
+			if err != nil {
+				return nil, fmt.Errorf("signature %q: cname_patterns: %w", s.Service, err)
+			}
+			sig.CNAMEPatterns = append(sig.CNAMEPatterns, re)
+		}
+		if s.HTTPFingerprint.Body != "" {
+			re, err := regexp.Compile("(?i)" + regexp.QuoteMeta(s.HTTPFingerprint.Body))
+			if err != nil {
+				return nil, fmt.Errorf("signature %q: http_fingerprint.body: %w", s.Service, err)
+			}
+			sig.HTTPBody = re
+		}
+		sigs = append(sigs, sig)
+	}
+	return sigs, nil
+}
+
+var (
+	mu             sync.Mutex
+	signatures     []Signature
+	signaturesOnce sync.Once
+)
+
+func loadBuiltin() {
+	signaturesOnce.Do(func() {
+		var specs []signatureSpec
+		if err := yaml.Unmarshal(builtinSignaturesYAML, &specs); err != nil {
+			return
+		}
+		compiled, err := compileSignatures(specs)
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		signatures = compiled
+		mu.Unlock()
+	})
+}
+
+// activeSignatures returns the currently loaded signature set, lazily
+// compiling the bundled starter database on first use.
+func activeSignatures() []Signature {
+	loadBuiltin()
+	mu.Lock()
+	defer mu.Unlock()
+	return signatures
+}
+
+// LoadSignaturesFile replaces the active signature database with the
+// entries in path, letting operators extend or swap the bundled starter
+// set without recompiling sweep.
+func LoadSignaturesFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read takeover signatures %s: %w", path, err)
+	}
+
+	var specs []signatureSpec
+	if err := yaml.Unmarshal(data, &specs); err != nil {
+		return fmt.Errorf("parse takeover signatures %s: %w", path, err)
+	}
+
+	compiled, err := compileSignatures(specs)
+	if err != nil {
+		return err
+	}
+	if len(compiled) == 0 {
+		return fmt.Errorf("no takeover signatures found in %s", path)
+	}
+
+	// Run the one-time built-in load now so it can't overwrite this set later.
+	loadBuiltin()
+	mu.Lock()
+	signatures = compiled
+	mu.Unlock()
+	return nil
+}
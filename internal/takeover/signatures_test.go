@@ -0,0 +1,61 @@
+package takeover
+
+import (
+	"os"
+	"testing"
+)
+
+func TestActiveSignatures_BundledStarterSetCoversKnownServices(t *testing.T) {
+	sigs := activeSignatures()
+	if len(sigs) == 0 {
+		t.Fatal("expected bundled signatures to load")
+	}
+
+	want := []struct {
+		service string
+		cname   string
+	}{
+		{"Heroku", "foo.herokuapp.com"},
+		{"GitHub Pages", "foo.github.io"},
+		{"Amazon S3", "foo.s3.amazonaws.com"},
+		{"Microsoft Azure", "foo.azurewebsites.net"},
+		{"Netlify", "foo.netlify.app"},
+	}
+
+	for _, w := range want {
+		var found *Signature
+		for i := range sigs {
+			if sigs[i].Matches(w.cname) {
+				found = &sigs[i]
+				break
+			}
+		}
+		if found == nil {
+			t.Errorf("no signature matched %q", w.cname)
+			continue
+		}
+		if found.Service != w.service {
+			t.Errorf("cname %q matched %q, want %q", w.cname, found.Service, w.service)
+		}
+	}
+}
+
+func TestCompileSignatures_InvalidRegexErrors(t *testing.T) {
+	specs := []signatureSpec{
+		{Service: "bad", CNAMEPatterns: []string{"("}},
+	}
+	if _, err := compileSignatures(specs); err == nil {
+		t.Error("expected an error for an invalid cname_patterns regex")
+	}
+}
+
+func TestLoadSignaturesFile_RejectsEmptyDatabase(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/empty.yaml"
+	if err := os.WriteFile(path, []byte("[]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadSignaturesFile(path); err == nil {
+		t.Error("expected an error for an empty signature database")
+	}
+}
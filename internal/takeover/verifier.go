@@ -0,0 +1,151 @@
+package takeover
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+const maxFingerprintBody = 64 * 1024
+
+// Verifier implements engine.TakeoverVerifier.
+type Verifier struct {
+	UserAgent string
+}
+
+// Verify matches each candidate's CNAME against the active signature
+// database and, for signatures that aren't nxdomain_only, HTTP-GETs the
+// host to confirm the service's takeover fingerprint is present.
+func (v *Verifier) Verify(ctx context.Context, candidates []engine.DanglingCNAME, concurrency int, timeout time.Duration) ([]engine.DanglingCNAME, error) {
+	sigs := activeSignatures()
+	if len(sigs) == 0 {
+		return candidates, nil
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	out := make([]engine.DanglingCNAME, len(candidates))
+	copy(out, candidates)
+
+	work := make(chan int, len(out))
+	for i := range out {
+		work <- i
+	}
+	close(work)
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range work {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				verified := verifyOne(ctx, client, out[idx], sigs, v.UserAgent)
+				mu.Lock()
+				out[idx] = verified
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return out, nil
+}
+
+func verifyOne(ctx context.Context, client *http.Client, c engine.DanglingCNAME, sigs []Signature, userAgent string) engine.DanglingCNAME {
+	var sig *Signature
+	for i := range sigs {
+		if sigs[i].Matches(c.CNAME) {
+			sig = &sigs[i]
+			break
+		}
+	}
+	if sig == nil {
+		return c
+	}
+
+	c.Service = sig.Service
+	c.Severity = sig.Severity
+
+	if sig.NXDomainOnly {
+		c.Status = "vulnerable"
+		c.Confirmed = true
+		c.Evidence = fmt.Sprintf("CNAME matches %s; dangling target confirms the endpoint is unclaimed", sig.Service)
+		return c
+	}
+
+	body, status, err := fetch(ctx, client, c.Host, userAgent)
+	if err != nil {
+		c.Status = "unclaimed"
+		c.Evidence = fmt.Sprintf("CNAME matches %s but HTTP probe failed: %s", sig.Service, err)
+		return c
+	}
+
+	statusMatches := sig.HTTPStatus == 0 || status == sig.HTTPStatus
+	bodyMatches := sig.HTTPBody == nil || sig.HTTPBody.MatchString(body)
+	if statusMatches && bodyMatches {
+		c.Status = "confirmed"
+		c.Confirmed = true
+		c.Evidence = fmt.Sprintf("%s takeover fingerprint matched (HTTP %d)", sig.Service, status)
+		return c
+	}
+
+	c.Status = "unclaimed"
+	c.Evidence = fmt.Sprintf("CNAME matches %s but the HTTP fingerprint did not match (HTTP %d)", sig.Service, status)
+	return c
+}
+
+// fetch GETs host over https then http, returning the first successful
+// response body and status.
+func fetch(ctx context.Context, client *http.Client, host, userAgent string) (string, int, error) {
+	var lastErr error
+	for _, scheme := range []string{"https", "http"} {
+		url := scheme + "://" + host
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxFingerprintBody))
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		return string(body), resp.StatusCode, nil
+	}
+	return "", 0, lastErr
+}
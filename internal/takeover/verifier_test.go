@@ -0,0 +1,64 @@
+package takeover
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vulnverified/sweep/internal/engine"
+)
+
+func TestVerifyOne_NXDomainOnlySignatureConfirmsWithoutHTTP(t *testing.T) {
+	sigs, err := compileSignatures([]signatureSpec{
+		{Service: "Acme CDN", CNAMEPatterns: []string{`\.acmecdn\.test$`}, NXDomainOnly: true, Severity: "high"},
+	})
+	if err != nil {
+		t.Fatalf("compileSignatures: %v", err)
+	}
+
+	c := engine.DanglingCNAME{Host: "foo.example.com", CNAME: "bar.acmecdn.test", Status: "NXDOMAIN"}
+	got := verifyOne(context.Background(), &http.Client{}, c, sigs, "")
+
+	if !got.Confirmed || got.Status != "vulnerable" {
+		t.Errorf("got Confirmed=%v Status=%q, want Confirmed=true Status=\"vulnerable\"", got.Confirmed, got.Status)
+	}
+}
+
+func TestVerifyOne_HTTPFingerprintMatchConfirms(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("no such app"))
+	}))
+	defer srv.Close()
+
+	sigs, err := compileSignatures([]signatureSpec{
+		{Service: "Heroku", CNAMEPatterns: []string{`\.herokuapp\.com$`}, Severity: "high", HTTPFingerprint: struct {
+			Status int    `yaml:"status"`
+			Body   string `yaml:"body"`
+		}{Status: 404, Body: "no such app"}},
+	})
+	if err != nil {
+		t.Fatalf("compileSignatures: %v", err)
+	}
+
+	host := srv.Listener.Addr().String()
+	c := engine.DanglingCNAME{Host: host, CNAME: "foo.herokuapp.com", Status: "NXDOMAIN"}
+	got := verifyOne(context.Background(), srv.Client(), c, sigs, "")
+
+	if !got.Confirmed || got.Status != "confirmed" {
+		t.Errorf("got Confirmed=%v Status=%q, want Confirmed=true Status=\"confirmed\"", got.Confirmed, got.Status)
+	}
+}
+
+func TestVerifyOne_NoMatchingSignatureLeavesCandidateUnconfirmed(t *testing.T) {
+	c := engine.DanglingCNAME{Host: "foo.example.com", CNAME: "bar.unknownhost.test", Status: "NXDOMAIN"}
+	got := verifyOne(context.Background(), &http.Client{}, c, nil, "")
+
+	if got.Confirmed {
+		t.Error("expected an unmatched CNAME to remain unconfirmed")
+	}
+	if got.Status != "NXDOMAIN" {
+		t.Errorf("status = %q, want unchanged %q", got.Status, "NXDOMAIN")
+	}
+}